@@ -40,7 +40,7 @@ var (
 )
 
 func init() {
-	rootCmd.AddCommand(versionCmd(), infoCmd())
+	rootCmd.AddCommand(versionCmd(), infoCmd(), snapshotCmd())
 	initK9sFlags()
 	initK8sFlags()
 }
@@ -116,6 +116,10 @@ func loadConfiguration() *config.Config {
 	k9sCfg.K9s.OverrideCommand(*k9sFlags.Command)
 	k9sCfg.K9s.OverrideScreenDumpDir(*k9sFlags.ScreenDumpDir)
 
+	if *k9sFlags.Snapshot != "" {
+		return loadSnapshot(k9sCfg, k8sCfg)
+	}
+
 	if err := k9sCfg.Refine(k8sFlags, k9sFlags, k8sCfg); err != nil {
 		log.Error().Err(err).Msgf("refine failed")
 	}
@@ -140,6 +144,39 @@ func loadConfiguration() *config.Config {
 	return k9sCfg
 }
 
+// loadSnapshot configures K9s to browse an offline snapshot instead of a live cluster.
+func loadSnapshot(k9sCfg *config.Config, k8sCfg *client.Config) *config.Config {
+	snap, err := client.LoadSnapshot(*k9sFlags.Snapshot)
+	if err != nil {
+		log.Panic().Msgf("Unable to load snapshot %q: %s", *k9sFlags.Snapshot, err)
+	}
+	kubeConfig, err := snap.WriteKubeConfig(os.TempDir())
+	if err != nil {
+		log.Panic().Msgf("Unable to write snapshot kubeconfig: %s", err)
+	}
+	context, blank := client.SnapshotContext, ""
+	k8sFlags.KubeConfig, k8sFlags.Context = &kubeConfig, &context
+	k8sFlags.ClusterName, k8sFlags.AuthInfoName = &blank, &blank
+	k8sFlags.WrapConfigFn = snap.WrapConfig
+	if *k8sFlags.Namespace == "" {
+		*k9sFlags.AllNamespaces = true
+	}
+	k9sCfg.K9s.OverrideSnapshot(snap.Path())
+	k9sCfg.K9s.SkipLatestRevCheck = true
+
+	if err := k9sCfg.Refine(k8sFlags, k9sFlags, k8sCfg); err != nil {
+		log.Error().Err(err).Msgf("refine failed")
+	}
+	conn, err := client.InitConnection(k8sCfg)
+	k9sCfg.SetConnection(conn)
+	if err != nil {
+		log.Panic().Msgf("Unable to serve snapshot %q: %s", snap.Path(), err)
+	}
+	log.Info().Msgf("📸 Browsing snapshot %s", snap.Path())
+
+	return k9sCfg
+}
+
 func parseLevel(level string) zerolog.Level {
 	switch level {
 	case "trace":
@@ -225,6 +262,12 @@ func initK9sFlags() {
 		"",
 		"Sets a path to a dir for a screen dumps",
 	)
+	rootCmd.Flags().StringVar(
+		k9sFlags.Snapshot,
+		"snapshot",
+		"",
+		"Browse an offline directory or tarball of YAML manifests in read-only mode",
+	)
 	rootCmd.Flags()
 }
 
@@ -0,0 +1,77 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/color"
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/cobra"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+)
+
+func snapshotCmd() *cobra.Command {
+	var (
+		namespaces, redact []string
+		keepSecretData     bool
+		screenDumpDir      string
+		flags              = genericclioptions.NewConfigFlags(client.UsePersistentConfig)
+	)
+
+	command := cobra.Command{
+		Use:   "snapshot",
+		Short: "Export fleet state to a tarball",
+		Long:  "Export all fleet resources and optionally the core resources of given namespaces to a tarball in the screen dump directory",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			k8sCfg := client.NewConfig(flags)
+			k9sCfg := config.NewConfig(k8sCfg)
+			if err := k9sCfg.Load(config.K9sConfigFile); err != nil {
+				log.Warn().Msg("Unable to locate K9s config. Using defaults...")
+			}
+			k9sCfg.K9s.OverrideScreenDumpDir(screenDumpDir)
+			if err := k9sCfg.Refine(flags, nil, k8sCfg); err != nil {
+				return err
+			}
+
+			opts := *k9sCfg.K9s.FleetConfig().SnapshotConfig()
+			if cmd.Flags().Changed("namespaces") {
+				opts.Namespaces = namespaces
+			}
+			if cmd.Flags().Changed("redact-properties") {
+				opts.RedactProperties = redact
+			}
+			if cmd.Flags().Changed("keep-secret-data") {
+				opts.KeepSecretData = keepSecretData
+			}
+
+			conn, err := client.InitConnection(k8sCfg)
+			if err != nil {
+				return err
+			}
+			ctx, cancel := context.WithTimeout(context.Background(), dao.SnapshotTimeout)
+			defer cancel()
+			dir := filepath.Join(k9sCfg.K9s.GetScreenDumpDir(), k9sCfg.K9s.CurrentContextDir())
+			path, err := dao.ExportFleetSnapshot(ctx, conn, dir, &opts)
+			if err != nil {
+				return err
+			}
+			printTuple("%-20s %s\n", "Snapshot", path, color.Cyan)
+
+			return nil
+		},
+	}
+
+	command.Flags().StringSliceVar(&namespaces, "namespaces", nil, "Namespaces whose core resources are exported along with the fleet resources")
+	command.Flags().StringSliceVar(&redact, "redact-properties", nil, fmt.Sprintf("Regular expressions matching cluster properties keys to redact (default %q)", config.DefaultRedactedProperties))
+	command.Flags().BoolVar(&keepSecretData, "keep-secret-data", false, "Export Secret data instead of redacting it")
+	command.Flags().StringVar(&screenDumpDir, "screen-dump-dir", "", "Sets a path to a dir for a screen dumps")
+	command.Flags().StringVar(flags.KubeConfig, "kubeconfig", "", "Path to the kubeconfig file to use for CLI requests")
+	command.Flags().StringVar(flags.Context, "context", "", "The name of the kubeconfig context to use")
+	command.Flags().StringVar(flags.Timeout, "request-timeout", "", "The length of time to wait before giving up on a single server request")
+
+	return &command
+}
@@ -15,6 +15,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/cache"
 	"k8s.io/apimachinery/pkg/version"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
 	"k8s.io/client-go/discovery/cached/disk"
 	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/kubernetes"
@@ -402,6 +403,21 @@ func (a *APIClient) SwitchContext(name string) error {
 	if err := a.config.SwitchContext(name); err != nil {
 		return err
 	}
+
+	return a.reconnect(name)
+}
+
+// RestoreContext reinstates saved configuration flags and switches to the given context.
+func (a *APIClient) RestoreContext(flags *genericclioptions.ConfigFlags, name string) error {
+	log.Debug().Msgf("Restoring context %q", name)
+	if err := a.config.RestoreContext(flags, name); err != nil {
+		return err
+	}
+
+	return a.reconnect(name)
+}
+
+func (a *APIClient) reconnect(name string) error {
 	a.mx.Lock()
 	{
 		a.reset()
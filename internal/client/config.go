@@ -49,7 +49,12 @@ func (c *Config) CallTimeout() time.Duration {
 }
 
 func (c *Config) RESTConfig() (*restclient.Config, error) {
-	return c.clientConfig().ClientConfig()
+	cfg, err := c.clientConfig().ClientConfig()
+	if err != nil || c.flags.WrapConfigFn == nil {
+		return cfg, err
+	}
+
+	return c.flags.WrapConfigFn(cfg), nil
 }
 
 // Flags returns configuration flags.
@@ -81,6 +86,31 @@ func (c *Config) SwitchContext(name string) error {
 	return nil
 }
 
+// SetKubeConfig changes the kubeconfig file the configuration is loaded from.
+func (c *Config) SetKubeConfig(path *string) {
+	flags := genericclioptions.NewConfigFlags(UsePersistentConfig)
+	flags.Context = c.flags.Context
+	flags.Timeout = c.flags.Timeout
+	flags.KubeConfig = path
+	c.flags = flags
+}
+
+// RestoreContext reinstates previously saved configuration flags and switches to the given context.
+// The saved flags are kept as is when they already target that context.
+func (c *Config) RestoreContext(flags *genericclioptions.ConfigFlags, name string) error {
+	prev := c.flags
+	c.flags = flags
+	if ctx, err := c.CurrentContextName(); err == nil && ctx == name {
+		return nil
+	}
+	if err := c.SwitchContext(name); err != nil {
+		c.flags = prev
+		return err
+	}
+
+	return nil
+}
+
 // CurrentContextName returns the currently active config context.
 func (c *Config) CurrentContextName() (string, error) {
 	if isSet(c.flags.Context) {
@@ -180,6 +180,41 @@ func TestConfigSwitchContext(t *testing.T) {
 	assert.Equal(t, "blee", ctx)
 }
 
+func TestConfigRestoreContext(t *testing.T) {
+	ctx, ns, user, kubeConfig := "fred", "zorg", "fred", "./testdata/config"
+	hub := genericclioptions.ConfigFlags{
+		KubeConfig:   &kubeConfig,
+		Context:      &ctx,
+		Namespace:    &ns,
+		AuthInfoName: &user,
+	}
+
+	cfg := client.NewConfig(&hub)
+	assert.Nil(t, cfg.SwitchContext("blee"))
+	assert.Equal(t, "", *cfg.Flags().Namespace)
+	member := cfg.Flags()
+
+	assert.Nil(t, cfg.RestoreContext(&hub, "fred"))
+	assert.Equal(t, &hub, cfg.Flags())
+	assert.Equal(t, "zorg", *cfg.Flags().Namespace)
+
+	assert.Nil(t, cfg.SwitchContext("fred"))
+	assert.NotEqual(t, &hub, cfg.Flags())
+
+	cfg = client.NewConfig(member)
+	assert.Nil(t, cfg.RestoreContext(&hub, "blee"))
+	assert.NotEqual(t, &hub, cfg.Flags())
+	assert.Equal(t, kubeConfig, *cfg.Flags().KubeConfig)
+	ctx, err := cfg.CurrentContextName()
+	assert.Nil(t, err)
+	assert.Equal(t, "blee", ctx)
+
+	assert.NotNil(t, cfg.RestoreContext(&hub, "zorg"))
+	ctx, err = cfg.CurrentContextName()
+	assert.Nil(t, err)
+	assert.Equal(t, "blee", ctx)
+}
+
 func TestConfigClusterNameFromContext(t *testing.T) {
 	cluster, kubeConfig := "duh", "./testdata/config"
 	flags := genericclioptions.ConfigFlags{
@@ -0,0 +1,406 @@
+package client
+
+import (
+	"archive/tar"
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/rs/zerolog/log"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/yaml"
+	restclient "k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+)
+
+// SnapshotContext represents the kubeconfig context name used while browsing a snapshot.
+const SnapshotContext = "snapshot"
+
+var snapshotVerbs = metav1.Verbs{GetVerb, ListVerb, WatchVerb}
+
+// snapshotShortNames tracks built-in resource short names since snapshots carry no discovery data.
+var snapshotShortNames = map[string][]string{
+	"configmaps":                {"cm"},
+	"cronjobs":                  {"cj"},
+	"customresourcedefinitions": {"crd", "crds"},
+	"daemonsets":                {"ds"},
+	"deployments":               {"deploy"},
+	"events":                    {"ev"},
+	"horizontalpodautoscalers":  {"hpa"},
+	"ingresses":                 {"ing"},
+	"namespaces":                {"ns"},
+	"nodes":                     {"no"},
+	"persistentvolumeclaims":    {"pvc"},
+	"persistentvolumes":         {"pv"},
+	"pods":                      {"po"},
+	"replicasets":               {"rs"},
+	"serviceaccounts":           {"sa"},
+	"services":                  {"svc"},
+	"statefulsets":              {"sts"},
+}
+
+// snapshotResource tracks all the snapshot objects of a given resource.
+type snapshotResource struct {
+	gvk   schema.GroupVersionKind
+	res   metav1.APIResource
+	items []*unstructured.Unstructured
+}
+
+// Snapshot represents an offline, read-only cluster dump loaded in memory.
+type Snapshot struct {
+	path      string
+	sum       uint32
+	resources map[schema.GroupVersionResource]*snapshotResource
+}
+
+// LoadSnapshot loads all the YAML or JSON manifests found in a directory or a tarball.
+func LoadSnapshot(path string) (*Snapshot, error) {
+	fi, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+	h := fnv.New32a()
+	var oo []*unstructured.Unstructured
+	collect := func(name string, r io.Reader) error {
+		_, _ = io.WriteString(h, name)
+		uu, err := decodeManifests(io.TeeReader(r, h))
+		if err != nil {
+			return fmt.Errorf("%s: %w", name, err)
+		}
+		oo = append(oo, uu...)
+		return nil
+	}
+	if fi.IsDir() {
+		err = walkSnapshotDir(path, collect)
+	} else {
+		err = readSnapshotFile(path, collect)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if len(oo) == 0 {
+		return nil, fmt.Errorf("no kubernetes manifests found in snapshot %q", path)
+	}
+
+	s := Snapshot{
+		path:      path,
+		sum:       h.Sum32(),
+		resources: make(map[schema.GroupVersionResource]*snapshotResource),
+	}
+	s.index(oo)
+
+	return &s, nil
+}
+
+// Path returns the snapshot location.
+func (s *Snapshot) Path() string {
+	return s.path
+}
+
+// Host returns the fictional api server address serving the snapshot.
+func (s *Snapshot) Host() string {
+	return fmt.Sprintf("http://%s-%08x", SnapshotContext, s.sum)
+}
+
+// WrapConfig routes a rest configuration to the snapshot.
+func (s *Snapshot) WrapConfig(cfg *restclient.Config) *restclient.Config {
+	cfg.Host, cfg.Transport = s.Host(), s
+
+	return cfg
+}
+
+// WriteKubeConfig writes a kubeconfig pointing to the snapshot and returns its location.
+func (s *Snapshot) WriteKubeConfig(dir string) (string, error) {
+	cfg := clientcmdapi.NewConfig()
+	cfg.Clusters[SnapshotContext] = &clientcmdapi.Cluster{Server: s.Host()}
+	cfg.AuthInfos[SnapshotContext] = &clientcmdapi.AuthInfo{}
+	cfg.Contexts[SnapshotContext] = &clientcmdapi.Context{
+		Cluster:  SnapshotContext,
+		AuthInfo: SnapshotContext,
+	}
+	cfg.CurrentContext = SnapshotContext
+
+	path := filepath.Join(dir, fmt.Sprintf("k9s-%s-%08x.kubeconfig", SnapshotContext, s.sum))
+
+	return path, clientcmd.WriteToFile(*cfg, path)
+}
+
+// Resources returns the snapshot resources by group version.
+func (s *Snapshot) Resources() map[schema.GroupVersion][]metav1.APIResource {
+	rr := make(map[schema.GroupVersion][]metav1.APIResource)
+	for gvr, r := range s.resources {
+		gv := gvr.GroupVersion()
+		rr[gv] = append(rr[gv], r.res)
+	}
+	for _, res := range rr {
+		sort.Slice(res, func(i, j int) bool {
+			return res[i].Name < res[j].Name
+		})
+	}
+
+	return rr
+}
+
+// List returns the objects of a resource in a given namespace. Blank namespace designates all namespaces.
+func (s *Snapshot) List(gvr schema.GroupVersionResource, ns string) []*unstructured.Unstructured {
+	r, ok := s.resources[gvr]
+	if !ok {
+		return nil
+	}
+	oo := make([]*unstructured.Unstructured, 0, len(r.items))
+	for _, o := range r.items {
+		if ns != "" && o.GetNamespace() != ns {
+			continue
+		}
+		oo = append(oo, o)
+	}
+
+	return oo
+}
+
+// Get returns a snapshot object or nil if none match.
+func (s *Snapshot) Get(gvr schema.GroupVersionResource, ns, n string) *unstructured.Unstructured {
+	for _, o := range s.List(gvr, ns) {
+		if o.GetName() == n {
+			return o
+		}
+	}
+
+	return nil
+}
+
+func (s *Snapshot) lookup(gvr schema.GroupVersionResource) (*snapshotResource, bool) {
+	r, ok := s.resources[gvr]
+	return r, ok
+}
+
+// index buckets snapshot objects by resource. CRDs present in the snapshot are used
+// to resolve custom resource names and scopes.
+func (s *Snapshot) index(oo []*unstructured.Unstructured) {
+	crds := make(map[schema.GroupKind]*unstructured.Unstructured)
+	for _, o := range oo {
+		if o.GetKind() == "CustomResourceDefinition" {
+			g, _, _ := unstructured.NestedString(o.Object, "spec", "group")
+			k, _, _ := unstructured.NestedString(o.Object, "spec", "names", "kind")
+			crds[schema.GroupKind{Group: g, Kind: k}] = o
+		}
+	}
+
+	s.register(schema.GroupVersionKind{Version: "v1", Kind: "Namespace"}, false, nil)
+	s.register(schema.GroupVersionKind{Version: "v1", Kind: "Pod"}, true, nil)
+	s.register(schema.GroupVersionKind{Version: "v1", Kind: "Event"}, true, nil)
+
+	for _, o := range oo {
+		gvk := o.GroupVersionKind()
+		s.register(gvk, o.GetNamespace() != "", crds[gvk.GroupKind()])
+	}
+	seen := make(map[string]struct{})
+	for _, o := range oo {
+		gvk := o.GroupVersionKind()
+		r := s.register(gvk, false, crds[gvk.GroupKind()])
+		if !r.res.Namespaced {
+			o.SetNamespace("")
+		}
+		key := gvk.String() + "|" + FQN(o.GetNamespace(), o.GetName())
+		if _, ok := seen[key]; ok {
+			log.Warn().Msgf("Snapshot skipping duplicate %s %q", gvk.Kind, FQN(o.GetNamespace(), o.GetName()))
+			continue
+		}
+		seen[key] = struct{}{}
+		r.items = append(r.items, o)
+	}
+
+	nss := s.resources[schema.GroupVersionResource{Version: "v1", Resource: "namespaces"}]
+	for _, ns := range s.namespaces() {
+		if s.Get(nss.gvk.GroupVersion().WithResource("namespaces"), "", ns) != nil {
+			continue
+		}
+		var o unstructured.Unstructured
+		o.SetGroupVersionKind(nss.gvk)
+		o.SetName(ns)
+		_ = unstructured.SetNestedField(o.Object, "Active", "status", "phase")
+		nss.items = append(nss.items, &o)
+	}
+
+	for _, r := range s.resources {
+		sort.Slice(r.items, func(i, j int) bool {
+			return FQN(r.items[i].GetNamespace(), r.items[i].GetName()) < FQN(r.items[j].GetNamespace(), r.items[j].GetName())
+		})
+	}
+}
+
+// register tracks a new snapshot resource or returns an existing one.
+func (s *Snapshot) register(gvk schema.GroupVersionKind, namespaced bool, crd *unstructured.Unstructured) *snapshotResource {
+	plural, singular := meta.UnsafeGuessKindToResource(gvk)
+	shortNames := snapshotShortNames[plural.Resource]
+	if crd != nil {
+		if p, _, _ := unstructured.NestedString(crd.Object, "spec", "names", "plural"); p != "" {
+			plural.Resource = p
+		}
+		if sg, _, _ := unstructured.NestedString(crd.Object, "spec", "names", "singular"); sg != "" {
+			singular.Resource = sg
+		}
+		shortNames, _, _ = unstructured.NestedStringSlice(crd.Object, "spec", "names", "shortNames")
+		scope, _, _ := unstructured.NestedString(crd.Object, "spec", "scope")
+		namespaced = scope == "Namespaced"
+	}
+	if r, ok := s.resources[plural]; ok {
+		if namespaced && crd == nil {
+			r.res.Namespaced = true
+		}
+		return r
+	}
+
+	r := snapshotResource{
+		gvk: gvk,
+		res: metav1.APIResource{
+			Name:         plural.Resource,
+			SingularName: singular.Resource,
+			Namespaced:   namespaced,
+			Group:        gvk.Group,
+			Version:      gvk.Version,
+			Kind:         gvk.Kind,
+			Verbs:        snapshotVerbs,
+			ShortNames:   shortNames,
+		},
+	}
+	s.resources[plural] = &r
+
+	return &r
+}
+
+// namespaces returns all the namespaces referenced by snapshot objects.
+func (s *Snapshot) namespaces() []string {
+	set := make(map[string]struct{})
+	for _, r := range s.resources {
+		for _, o := range r.items {
+			if ns := o.GetNamespace(); ns != "" {
+				set[ns] = struct{}{}
+			}
+		}
+	}
+	nn := make([]string, 0, len(set))
+	for ns := range set {
+		nn = append(nn, ns)
+	}
+	sort.Strings(nn)
+
+	return nn
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func isManifestFile(name string) bool {
+	switch strings.ToLower(filepath.Ext(name)) {
+	case ".yaml", ".yml", ".json":
+		return true
+	default:
+		return false
+	}
+}
+
+func walkSnapshotDir(dir string, collect func(string, io.Reader) error) error {
+	return filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() || !isManifestFile(path) {
+			return nil
+		}
+		f, err := os.Open(path)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+
+		return collect(path, f)
+	})
+}
+
+// readSnapshotFile reads a single manifest file or a possibly gzipped tarball.
+func readSnapshotFile(path string, collect func(string, io.Reader) error) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	var r io.Reader = bufio.NewReader(f)
+	if magic, _ := r.(*bufio.Reader).Peek(2); bytes.Equal(magic, []byte{0x1f, 0x8b}) {
+		gz, err := gzip.NewReader(r)
+		if err != nil {
+			return err
+		}
+		defer gz.Close()
+		r = gz
+	} else if isManifestFile(path) {
+		return collect(path, r)
+	}
+
+	tr := tar.NewReader(r)
+	for {
+		hdr, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("%s: %w", path, err)
+		}
+		if hdr.Typeflag != tar.TypeReg || !isManifestFile(hdr.Name) {
+			continue
+		}
+		if err := collect(hdr.Name, tr); err != nil {
+			return err
+		}
+	}
+}
+
+// decodeManifests decodes a stream of YAML or JSON documents, expanding lists.
+func decodeManifests(r io.Reader) ([]*unstructured.Unstructured, error) {
+	var oo []*unstructured.Unstructured
+	d := yaml.NewYAMLOrJSONDecoder(r, 4096)
+	for {
+		var m map[string]interface{}
+		if err := d.Decode(&m); err != nil {
+			if errors.Is(err, io.EOF) {
+				return oo, nil
+			}
+			return nil, err
+		}
+		if len(m) == 0 {
+			continue
+		}
+		u := unstructured.Unstructured{Object: m}
+		if !u.IsList() {
+			if u.GetKind() == "" || u.GetAPIVersion() == "" || u.GetName() == "" {
+				continue
+			}
+			oo = append(oo, &u)
+			continue
+		}
+		err := u.EachListItem(func(o runtime.Object) error {
+			if i, ok := o.(*unstructured.Unstructured); ok && i.GetKind() != "" && i.GetName() != "" {
+				oo = append(oo, i)
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+}
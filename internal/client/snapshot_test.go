@@ -0,0 +1,134 @@
+package client
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/kubernetes"
+	restclient "k8s.io/client-go/rest"
+)
+
+var (
+	podGVR     = schema.GroupVersionResource{Version: "v1", Resource: "pods"}
+	nsGVR      = schema.GroupVersionResource{Version: "v1", Resource: "namespaces"}
+	appGVR     = schema.GroupVersionResource{Group: "apis.clusterfleet.io", Version: "v1alpha1", Resource: "applications"}
+	clusterGVR = schema.GroupVersionResource{Group: "apis.clusterfleet.io", Version: "v1alpha1", Resource: "clusters"}
+)
+
+func TestLoadSnapshotDir(t *testing.T) {
+	s, err := LoadSnapshot("testdata/snapshot")
+	assert.Nil(t, err)
+
+	assert.Equal(t, 2, len(s.List(podGVR, "")))
+	assert.Equal(t, 1, len(s.List(podGVR, "cache")))
+	assert.Equal(t, 1, len(s.List(appGVR, "fleet")))
+	assert.NotNil(t, s.Get(clusterGVR, "", "member-1"))
+	assert.Nil(t, s.Get(podGVR, "default", "redis"))
+
+	nn := make([]string, 0, 3)
+	for _, o := range s.List(nsGVR, "") {
+		nn = append(nn, o.GetName())
+	}
+	assert.Equal(t, []string{"cache", "default", "fleet"}, nn)
+
+	rr := s.Resources()[clusterGVR.GroupVersion()]
+	assert.Equal(t, 2, len(rr))
+	assert.Equal(t, "applications", rr[0].Name)
+	assert.True(t, rr[0].Namespaced)
+	assert.Equal(t, "clusters", rr[1].Name)
+	assert.False(t, rr[1].Namespaced)
+}
+
+func TestLoadSnapshotTarball(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "dump.tar.gz")
+	f, err := os.Create(path)
+	assert.Nil(t, err)
+	gz := gzip.NewWriter(f)
+	tw := tar.NewWriter(gz)
+	raw, err := os.ReadFile("testdata/snapshot/pods.yaml")
+	assert.Nil(t, err)
+	assert.Nil(t, tw.WriteHeader(&tar.Header{Name: "dump/pods.yaml", Mode: 0600, Size: int64(len(raw)), Typeflag: tar.TypeReg}))
+	_, err = tw.Write(raw)
+	assert.Nil(t, err)
+	assert.Nil(t, tw.Close())
+	assert.Nil(t, gz.Close())
+	assert.Nil(t, f.Close())
+
+	s, err := LoadSnapshot(path)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(s.List(podGVR, "")))
+}
+
+func TestLoadSnapshotEmpty(t *testing.T) {
+	_, err := LoadSnapshot(t.TempDir())
+	assert.ErrorContains(t, err, "no kubernetes manifests found")
+}
+
+func TestSnapshotTransport(t *testing.T) {
+	s, err := LoadSnapshot("testdata/snapshot")
+	assert.Nil(t, err)
+	cfg := s.WrapConfig(&restclient.Config{})
+	ctx := context.Background()
+
+	dial, err := dynamic.NewForConfig(cfg)
+	assert.Nil(t, err)
+	l, err := dial.Resource(podGVR).Namespace("").List(ctx, metav1.ListOptions{LabelSelector: "app=redis"})
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(l.Items))
+	assert.Equal(t, "redis", l.Items[0].GetName())
+
+	l, err = dial.Resource(podGVR).Namespace("").List(ctx, metav1.ListOptions{FieldSelector: "spec.nodeName=n1"})
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(l.Items))
+	assert.Equal(t, "nginx", l.Items[0].GetName())
+
+	o, err := dial.Resource(appGVR).Namespace("fleet").Get(ctx, "web", metav1.GetOptions{})
+	assert.Nil(t, err)
+	assert.Equal(t, "Application", o.GetKind())
+
+	_, err = dial.Resource(podGVR).Namespace("default").Get(ctx, "fred", metav1.GetOptions{})
+	assert.True(t, apierrors.IsNotFound(err))
+
+	err = dial.Resource(podGVR).Namespace("default").Delete(ctx, "nginx", metav1.DeleteOptions{})
+	assert.True(t, apierrors.IsForbidden(err))
+
+	w, err := dial.Resource(podGVR).Namespace("").Watch(ctx, metav1.ListOptions{})
+	assert.Nil(t, err)
+	w.Stop()
+
+	c, err := kubernetes.NewForConfig(cfg)
+	assert.Nil(t, err)
+	nss, err := c.CoreV1().Namespaces().List(ctx, metav1.ListOptions{})
+	assert.Nil(t, err)
+	assert.Equal(t, 3, len(nss.Items))
+
+	v, err := c.Discovery().ServerVersion()
+	assert.Nil(t, err)
+	assert.Equal(t, SnapshotContext, v.GitVersion)
+
+	sar := makeSAR("default", "v1/pods")
+	sar.Spec.ResourceAttributes.Verb = ListVerb
+	res, err := c.AuthorizationV1().SelfSubjectAccessReviews().Create(ctx, sar, metav1.CreateOptions{})
+	assert.Nil(t, err)
+	assert.True(t, res.Status.Allowed)
+	sar.Spec.ResourceAttributes.Verb = DeleteVerb
+	res, err = c.AuthorizationV1().SelfSubjectAccessReviews().Create(ctx, sar, metav1.CreateOptions{})
+	assert.Nil(t, err)
+	assert.False(t, res.Status.Allowed)
+
+	dc, err := discovery.NewDiscoveryClientForConfig(cfg)
+	assert.Nil(t, err)
+	rr, err := dc.ServerPreferredResources()
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(rr))
+}
@@ -0,0 +1,400 @@
+package client
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	authorizationv1 "k8s.io/api/authorization/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/duration"
+	"k8s.io/apimachinery/pkg/version"
+)
+
+const (
+	snapshotRV           = "1"
+	snapshotWatchTimeout = 5 * time.Minute
+)
+
+// snapshotRequest represents a resource request against a snapshot.
+type snapshotRequest struct {
+	gvr         schema.GroupVersionResource
+	ns, n       string
+	subresource string
+}
+
+// RoundTrip serves api server requests from the snapshot. Only reads are
+// allowed. Watches never emit events since a snapshot never changes.
+func (s *Snapshot) RoundTrip(req *http.Request) (*http.Response, error) {
+	switch req.URL.Path {
+	case "/version", "/version/":
+		return jsonResponse(req, http.StatusOK, &version.Info{
+			Major:      "0",
+			Minor:      "0",
+			GitVersion: SnapshotContext,
+			Platform:   "offline",
+		})
+	case "/api", "/api/":
+		return jsonResponse(req, http.StatusOK, &metav1.APIVersions{
+			TypeMeta: metav1.TypeMeta{Kind: "APIVersions"},
+			Versions: []string{"v1"},
+		})
+	case "/apis", "/apis/":
+		return jsonResponse(req, http.StatusOK, s.groupList())
+	}
+
+	if req.Method == http.MethodPost && strings.HasSuffix(req.URL.Path, "/selfsubjectaccessreviews") {
+		return s.accessReview(req)
+	}
+
+	sr, ok := parseSnapshotPath(req.URL.Path)
+	if !ok {
+		return statusResponse(req, apierrors.NewNotFound(schema.GroupResource{}, req.URL.Path))
+	}
+	if sr.gvr.Resource == "" {
+		return s.resourceList(req, sr.gvr.GroupVersion())
+	}
+	gr := sr.gvr.GroupResource()
+	if req.Method != http.MethodGet {
+		return statusResponse(req, apierrors.NewForbidden(gr, sr.n, fmt.Errorf("snapshot %s is read-only", s.path)))
+	}
+	r, ok := s.lookup(sr.gvr)
+	if !ok || sr.subresource != "" {
+		return statusResponse(req, apierrors.NewNotFound(gr, sr.n))
+	}
+
+	q := req.URL.Query()
+	if sr.n != "" {
+		o := s.Get(sr.gvr, sr.ns, sr.n)
+		if o == nil {
+			return statusResponse(req, apierrors.NewNotFound(gr, sr.n))
+		}
+		if wantsTable(req) {
+			return jsonResponse(req, http.StatusOK, toTable(r, tableVersion(req), []*unstructured.Unstructured{o}))
+		}
+		return jsonResponse(req, http.StatusOK, o)
+	}
+
+	oo, err := filterSnapshot(s.List(sr.gvr, sr.ns), q.Get("labelSelector"), q.Get("fieldSelector"))
+	if err != nil {
+		return statusResponse(req, apierrors.NewBadRequest(err.Error()))
+	}
+	if b, _ := strconv.ParseBool(q.Get("watch")); b {
+		return watchResponse(req, q.Get("timeoutSeconds"))
+	}
+	if wantsTable(req) {
+		return jsonResponse(req, http.StatusOK, toTable(r, tableVersion(req), oo))
+	}
+
+	return jsonResponse(req, http.StatusOK, toList(r, oo))
+}
+
+func (s *Snapshot) groupList() *metav1.APIGroupList {
+	gg := make(map[string][]string)
+	for gv := range s.Resources() {
+		if gv.Group == "" {
+			continue
+		}
+		gg[gv.Group] = append(gg[gv.Group], gv.Version)
+	}
+	l := metav1.APIGroupList{TypeMeta: metav1.TypeMeta{Kind: "APIGroupList", APIVersion: "v1"}}
+	for g, vv := range gg {
+		sort.Strings(vv)
+		group := metav1.APIGroup{Name: g}
+		for _, v := range vv {
+			group.Versions = append(group.Versions, metav1.GroupVersionForDiscovery{
+				GroupVersion: schema.GroupVersion{Group: g, Version: v}.String(),
+				Version:      v,
+			})
+		}
+		group.PreferredVersion = group.Versions[len(group.Versions)-1]
+		l.Groups = append(l.Groups, group)
+	}
+	sort.Slice(l.Groups, func(i, j int) bool {
+		return l.Groups[i].Name < l.Groups[j].Name
+	})
+
+	return &l
+}
+
+func (s *Snapshot) resourceList(req *http.Request, gv schema.GroupVersion) (*http.Response, error) {
+	rr, ok := s.Resources()[gv]
+	if !ok {
+		return statusResponse(req, apierrors.NewNotFound(schema.GroupResource{Group: gv.Group}, gv.Version))
+	}
+
+	return jsonResponse(req, http.StatusOK, &metav1.APIResourceList{
+		TypeMeta:     metav1.TypeMeta{Kind: "APIResourceList", APIVersion: "v1"},
+		GroupVersion: gv.String(),
+		APIResources: rr,
+	})
+}
+
+// accessReview grants read access to all snapshot resources.
+func (s *Snapshot) accessReview(req *http.Request) (*http.Response, error) {
+	var sar authorizationv1.SelfSubjectAccessReview
+	if err := json.NewDecoder(req.Body).Decode(&sar); err != nil {
+		return statusResponse(req, apierrors.NewBadRequest(err.Error()))
+	}
+	if ra := sar.Spec.ResourceAttributes; ra != nil {
+		for _, v := range snapshotVerbs {
+			if ra.Verb == v {
+				sar.Status.Allowed = true
+			}
+		}
+	}
+	sar.TypeMeta = metav1.TypeMeta{Kind: "SelfSubjectAccessReview", APIVersion: authorizationv1.SchemeGroupVersion.String()}
+	if !sar.Status.Allowed {
+		sar.Status.Reason = "snapshot is read-only"
+	}
+
+	return jsonResponse(req, http.StatusCreated, &sar)
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// parseSnapshotPath parses an api server resource path.
+func parseSnapshotPath(p string) (snapshotRequest, bool) {
+	var sr snapshotRequest
+	tokens := strings.Split(strings.Trim(p, "/"), "/")
+	switch {
+	case len(tokens) >= 2 && tokens[0] == "api":
+		sr.gvr.Version, tokens = tokens[1], tokens[2:]
+	case len(tokens) >= 3 && tokens[0] == "apis":
+		sr.gvr.Group, sr.gvr.Version, tokens = tokens[1], tokens[2], tokens[3:]
+	default:
+		return sr, false
+	}
+	if len(tokens) >= 3 && tokens[0] == "namespaces" {
+		sr.ns, tokens = tokens[1], tokens[2:]
+	}
+	if len(tokens) > 3 {
+		return sr, false
+	}
+	for i, t := range tokens {
+		switch i {
+		case 0:
+			sr.gvr.Resource = t
+		case 1:
+			sr.n = t
+		case 2:
+			sr.subresource = t
+		}
+	}
+
+	return sr, true
+}
+
+func filterSnapshot(oo []*unstructured.Unstructured, labelSel, fieldSel string) ([]*unstructured.Unstructured, error) {
+	lsel, err := labels.Parse(labelSel)
+	if err != nil {
+		return nil, err
+	}
+	fsel, err := fields.ParseSelector(fieldSel)
+	if err != nil {
+		return nil, err
+	}
+	rr := make([]*unstructured.Unstructured, 0, len(oo))
+	for _, o := range oo {
+		if !lsel.Matches(labels.Set(o.GetLabels())) || !fsel.Matches(fieldSet(o, fsel)) {
+			continue
+		}
+		rr = append(rr, o)
+	}
+
+	return rr, nil
+}
+
+// fieldSet extracts the object fields referenced by a field selector.
+func fieldSet(o *unstructured.Unstructured, sel fields.Selector) fields.Set {
+	set := make(fields.Set)
+	for _, r := range sel.Requirements() {
+		v, ok, _ := unstructured.NestedFieldNoCopy(o.Object, strings.Split(r.Field, ".")...)
+		if ok {
+			set[r.Field] = fmt.Sprintf("%v", v)
+		}
+	}
+
+	return set
+}
+
+func wantsTable(req *http.Request) bool {
+	return strings.Contains(req.Header.Get("Accept"), "as=Table")
+}
+
+// tableVersion returns the meta table version a request asked for.
+func tableVersion(req *http.Request) string {
+	for _, p := range strings.Split(req.Header.Get("Accept"), ";") {
+		if v := strings.TrimPrefix(strings.TrimSpace(p), "v="); v != strings.TrimSpace(p) {
+			return metav1.GroupName + "/" + v
+		}
+	}
+
+	return metav1.SchemeGroupVersion.String()
+}
+
+func toList(r *snapshotResource, oo []*unstructured.Unstructured) *unstructured.UnstructuredList {
+	l := unstructured.UnstructuredList{Object: map[string]interface{}{}}
+	l.SetAPIVersion(r.gvk.GroupVersion().String())
+	l.SetKind(r.gvk.Kind + "List")
+	l.SetResourceVersion(snapshotRV)
+	l.Items = make([]unstructured.Unstructured, 0, len(oo))
+	for _, o := range oo {
+		l.Items = append(l.Items, *o)
+	}
+
+	return &l
+}
+
+// toTable renders snapshot objects in the api server tabular format.
+func toTable(r *snapshotResource, apiVersion string, oo []*unstructured.Unstructured) *metav1.Table {
+	t := metav1.Table{
+		TypeMeta: metav1.TypeMeta{Kind: "Table", APIVersion: apiVersion},
+		ListMeta: metav1.ListMeta{ResourceVersion: snapshotRV},
+	}
+	if r.gvk.Kind == "Event" && r.gvk.Group == "" {
+		t.ColumnDefinitions = eventColumns()
+	} else {
+		t.ColumnDefinitions = []metav1.TableColumnDefinition{
+			{Name: "Name", Type: "string", Format: "name"},
+			{Name: "Age", Type: "string"},
+		}
+	}
+	t.Rows = make([]metav1.TableRow, 0, len(oo))
+	for _, o := range oo {
+		var cells []interface{}
+		if r.gvk.Kind == "Event" && r.gvk.Group == "" {
+			cells = eventCells(o)
+		} else {
+			cells = []interface{}{o.GetName(), snapshotAge(o.GetCreationTimestamp())}
+		}
+		t.Rows = append(t.Rows, metav1.TableRow{
+			Cells:  cells,
+			Object: runtime.RawExtension{Object: partialMeta(o)},
+		})
+	}
+
+	return &t
+}
+
+func partialMeta(o *unstructured.Unstructured) *metav1.PartialObjectMetadata {
+	return &metav1.PartialObjectMetadata{
+		TypeMeta: metav1.TypeMeta{Kind: "PartialObjectMetadata", APIVersion: metav1.SchemeGroupVersion.String()},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              o.GetName(),
+			Namespace:         o.GetNamespace(),
+			UID:               o.GetUID(),
+			CreationTimestamp: o.GetCreationTimestamp(),
+			Labels:            o.GetLabels(),
+		},
+	}
+}
+
+func eventColumns() []metav1.TableColumnDefinition {
+	cc := []string{"Last Seen", "Type", "Reason", "Object", "Subobject", "Source", "Message", "First Seen", "Count", "Name"}
+	dd := make([]metav1.TableColumnDefinition, 0, len(cc))
+	for _, c := range cc {
+		dd = append(dd, metav1.TableColumnDefinition{Name: c, Type: "string"})
+	}
+
+	return dd
+}
+
+func eventCells(o *unstructured.Unstructured) []interface{} {
+	str := func(ff ...string) string {
+		s, _, _ := unstructured.NestedString(o.Object, ff...)
+		return s
+	}
+	ts := func(ff ...string) string {
+		var t metav1.Time
+		if err := t.UnmarshalQueryParameter(str(ff...)); err != nil {
+			return ""
+		}
+		return snapshotAge(t)
+	}
+	count, _, _ := unstructured.NestedInt64(o.Object, "count")
+	object := strings.ToLower(str("involvedObject", "kind")) + "/" + str("involvedObject", "name")
+	source := str("source", "component")
+	if h := str("source", "host"); h != "" {
+		source += ", " + h
+	}
+
+	return []interface{}{
+		ts("lastTimestamp"),
+		str("type"),
+		str("reason"),
+		object,
+		str("involvedObject", "fieldPath"),
+		source,
+		str("message"),
+		ts("firstTimestamp"),
+		count,
+		o.GetName(),
+	}
+}
+
+func snapshotAge(t metav1.Time) string {
+	if t.IsZero() {
+		return "<unknown>"
+	}
+
+	return duration.HumanDuration(time.Since(t.Time))
+}
+
+// watchResponse streams no events until the watch times out or is cancelled.
+func watchResponse(req *http.Request, timeout string) (*http.Response, error) {
+	d := snapshotWatchTimeout
+	if secs, err := strconv.Atoi(timeout); err == nil && secs > 0 {
+		d = time.Duration(secs) * time.Second
+	}
+	pr, pw := io.Pipe()
+	go func() {
+		select {
+		case <-req.Context().Done():
+		case <-time.After(d):
+		}
+		_ = pw.Close()
+	}()
+
+	return &http.Response{
+		StatusCode: http.StatusOK,
+		Header:     http.Header{"Content-Type": []string{"application/json"}},
+		Body:       pr,
+		Request:    req,
+	}, nil
+}
+
+func statusResponse(req *http.Request, err *apierrors.StatusError) (*http.Response, error) {
+	st := err.Status()
+	st.TypeMeta = metav1.TypeMeta{Kind: "Status", APIVersion: "v1"}
+
+	return jsonResponse(req, int(st.Code), &st)
+}
+
+func jsonResponse(req *http.Request, code int, o interface{}) (*http.Response, error) {
+	raw, err := json.Marshal(o)
+	if err != nil {
+		return nil, err
+	}
+
+	return &http.Response{
+		StatusCode:    code,
+		Header:        http.Header{"Content-Type": []string{"application/json"}},
+		Body:          io.NopCloser(bytes.NewReader(raw)),
+		ContentLength: int64(len(raw)),
+		Request:       req,
+	}, nil
+}
@@ -3,6 +3,7 @@ package client
 import (
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/version"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
 	"k8s.io/client-go/discovery/cached/disk"
 	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/kubernetes"
@@ -97,6 +98,9 @@ type Connection interface {
 	// SwitchContext switches cluster based on context.
 	SwitchContext(ctx string) error
 
+	// RestoreContext reinstates saved configuration flags and switches cluster based on context.
+	RestoreContext(flags *genericclioptions.ConfigFlags, ctx string) error
+
 	// CachedDiscovery connects to discovery client.
 	CachedDiscovery() (*disk.CachedDiscoveryClient, error)
 
@@ -141,6 +141,15 @@ func (a *Aliases) loadDefaultAliases() {
 	a.Alias["ro"] = "rbac.authorization.k8s.io/v1/roles"
 	a.Alias["rb"] = "rbac.authorization.k8s.io/v1/rolebindings"
 	a.Alias["np"] = "networking.k8s.io/v1/networkpolicies"
+	a.Alias["chp"] = "apis.clusterfleet.io/v1alpha1/clusterhealthpolicies"
+	a.Alias["chr"] = "apis.clusterfleet.io/v1alpha1/clusterhealthreports"
+	a.Alias["fdb"] = "apis.clusterfleet.io/v1alpha1/fleetdisruptions"
+	a.Alias["ccs"] = "apis.clusterfleet.io/v1alpha1/crossclusterservices"
+	a.Alias["ccsh"] = "apis.clusterfleet.io/v1alpha1/crossclustershards"
+	a.Alias["fgs"] = "apis.clusterfleet.io/v1alpha1/finegrainedsyncers"
+	a.Alias["mw"] = "apis.clusterfleet.io/v1alpha1/manifestworks"
+	a.Alias["cldef"] = "apis.clusterfleet.io/v1alpha1/clusterdefinitions"
+	a.Alias["clpro"] = "apis.clusterfleet.io/v1alpha1/clusterprovisioners"
 
 	a.declare("help", "h", "?")
 	a.declare("quit", "q", "q!", "qa", "Q")
@@ -155,6 +164,7 @@ func (a *Aliases) loadDefaultAliases() {
 	a.declare("benchmarks", "bench", "benchmark", "be")
 	a.declare("screendumps", "screendump", "sd")
 	a.declare("pulses", "pulse", "pu", "hz")
+	a.declare("fleetpulses", "fleetpulse", "fpu")
 	a.declare("xrays", "xray", "x")
 }
 
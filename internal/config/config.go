@@ -240,6 +240,9 @@ func (c *Config) Load(path string) error {
 
 // Save configuration to disk.
 func (c *Config) Save() error {
+	if c.K9s.Snapshot() != "" || c.K9s.IsFleetMember() {
+		return nil
+	}
 	c.Validate()
 
 	return c.SaveFile(K9sConfigFile)
@@ -0,0 +1,130 @@
+package config
+
+import (
+	"fmt"
+	"regexp"
+	"time"
+)
+
+// Fleet tracks fleet hub options.
+type Fleet struct {
+	// MemberContexts resolves fleet clusters to their member cluster kube context.
+	MemberContexts *MemberContexts `yaml:"memberContexts,omitempty"`
+
+	// Snapshot tracks fleet snapshot export options.
+	Snapshot *FleetSnapshot `yaml:"snapshot,omitempty"`
+}
+
+// NewFleet returns a new instance.
+func NewFleet() *Fleet {
+	return &Fleet{
+		MemberContexts: NewMemberContexts(),
+	}
+}
+
+// SnapshotConfig returns the snapshot export options or defaults if none are set.
+func (f *Fleet) SnapshotConfig() *FleetSnapshot {
+	if f.Snapshot == nil {
+		return NewFleetSnapshot()
+	}
+
+	return f.Snapshot
+}
+
+// MemberContexts tracks how fleet clusters map to member cluster kube contexts.
+// Explicit contexts win over the context template which wins over a kubeconfig Secret.
+// Templates are rendered against the fleet cluster Name, Labels, KeyIdentifiers and
+// provisioning Properties.
+type MemberContexts struct {
+	// Contexts maps fleet cluster names to kube context names.
+	Contexts map[string]string `yaml:"contexts,omitempty"`
+
+	// ContextTemplate renders a kube context name from a fleet cluster.
+	ContextTemplate string `yaml:"contextTemplate,omitempty"`
+
+	// Secret locates a member cluster kubeconfig stored on the fleet hub.
+	Secret *KubeconfigSecret `yaml:"secret,omitempty"`
+
+	// Timeout bounds calls made to a single member cluster.
+	Timeout string `yaml:"timeout,omitempty"`
+}
+
+// NewMemberContexts returns a new instance.
+func NewMemberContexts() *MemberContexts {
+	return &MemberContexts{
+		Contexts: make(map[string]string),
+	}
+}
+
+// MemberTimeout returns the member cluster call timeout or the default if not set.
+func (m *MemberContexts) MemberTimeout() time.Duration {
+	if m.Timeout == "" {
+		return DefaultMemberTimeout
+	}
+	d, err := time.ParseDuration(m.Timeout)
+	if err != nil || d <= 0 {
+		return DefaultMemberTimeout
+	}
+
+	return d
+}
+
+// DefaultMemberTimeout tracks the default member cluster call timeout.
+const DefaultMemberTimeout = 5 * time.Second
+
+// DefaultKubeconfigSecretKey tracks the default Secret key holding a kubeconfig.
+const DefaultKubeconfigSecretKey = "kubeconfig"
+
+// KubeconfigSecret locates a kubeconfig stored in a Secret. Namespace and name are templates.
+type KubeconfigSecret struct {
+	Namespace string `yaml:"namespace"`
+	Name      string `yaml:"name"`
+	Key       string `yaml:"key,omitempty"`
+}
+
+// SecretKey returns the Secret data key holding the kubeconfig.
+func (k *KubeconfigSecret) SecretKey() string {
+	if k.Key == "" {
+		return DefaultKubeconfigSecretKey
+	}
+
+	return k.Key
+}
+
+// DefaultRedactedProperties tracks the cluster properties keys redacted when no patterns are configured.
+var DefaultRedactedProperties = []string{`(?i)(secret|passw(or)?d|token|credential|private.?key)`}
+
+// FleetSnapshot tracks fleet snapshot export options.
+type FleetSnapshot struct {
+	// Namespaces lists the namespaces whose core resources are exported along with the fleet resources.
+	Namespaces []string `yaml:"namespaces,omitempty"`
+
+	// KeepSecretData exports Secret data as is instead of redacting it.
+	KeepSecretData bool `yaml:"keepSecretData,omitempty"`
+
+	// RedactProperties lists regular expressions matching fleet cluster properties keys to redact.
+	RedactProperties []string `yaml:"redactProperties,omitempty"`
+}
+
+// NewFleetSnapshot returns a new instance.
+func NewFleetSnapshot() *FleetSnapshot {
+	return &FleetSnapshot{}
+}
+
+// PropertyRedactors returns the compiled cluster properties redaction patterns.
+func (f *FleetSnapshot) PropertyRedactors() ([]*regexp.Regexp, error) {
+	pp := f.RedactProperties
+	if len(pp) == 0 {
+		pp = DefaultRedactedProperties
+	}
+	rr := make([]*regexp.Regexp, 0, len(pp))
+	for _, p := range pp {
+		rx, err := regexp.Compile(p)
+		if err != nil {
+			return nil, fmt.Errorf("invalid redaction pattern %q: %w", p, err)
+		}
+		rr = append(rr, rx)
+	}
+
+	return rr, nil
+}
@@ -27,6 +27,7 @@ type K9s struct {
 	Clusters            map[string]*Cluster `yaml:"clusters,omitempty"`
 	Thresholds          Threshold           `yaml:"thresholds"`
 	ScreenDumpDir       string              `yaml:"screenDumpDir"`
+	Fleet               *Fleet              `yaml:"fleet,omitempty"`
 	manualRefreshRate   int
 	manualHeadless      *bool
 	manualLogoless      *bool
@@ -34,6 +35,8 @@ type K9s struct {
 	manualReadOnly      *bool
 	manualCommand       *string
 	manualScreenDumpDir *string
+	snapshot            string
+	fleetMember         bool
 }
 
 // NewK9s create a new K9s configuration.
@@ -48,6 +51,18 @@ func NewK9s() *K9s {
 	}
 }
 
+// FleetConfig returns the fleet hub options or defaults if none are set.
+func (k *K9s) FleetConfig() *Fleet {
+	if k.Fleet == nil {
+		return NewFleet()
+	}
+	if k.Fleet.MemberContexts == nil {
+		k.Fleet.MemberContexts = NewMemberContexts()
+	}
+
+	return k.Fleet
+}
+
 func (k *K9s) CurrentContextDir() string {
 	return SanitizeFilename(k.CurrentContext)
 }
@@ -110,6 +125,26 @@ func (k *K9s) OverrideScreenDumpDir(dir string) {
 	k.manualScreenDumpDir = &dir
 }
 
+// OverrideSnapshot sets the offline snapshot being browsed.
+func (k *K9s) OverrideSnapshot(path string) {
+	k.snapshot = path
+}
+
+// Snapshot returns the offline snapshot being browsed or blank if none.
+func (k *K9s) Snapshot() string {
+	return k.snapshot
+}
+
+// OverrideFleetMember flags whether a fleet member cluster context is being browsed.
+func (k *K9s) OverrideFleetMember(b bool) {
+	k.fleetMember = b
+}
+
+// IsFleetMember returns true if a fleet member cluster context is being browsed.
+func (k *K9s) IsFleetMember() bool {
+	return k.fleetMember
+}
+
 // IsHeadless returns headless setting.
 func (k *K9s) IsHeadless() bool {
 	h := k.Headless
@@ -150,8 +185,11 @@ func (k *K9s) GetRefreshRate() int {
 	return rate
 }
 
-// IsReadOnly returns the readonly setting.
+// IsReadOnly returns the readonly setting. Snapshots are always read only.
 func (k *K9s) IsReadOnly() bool {
+	if k.snapshot != "" {
+		return true
+	}
 	readOnly := k.ReadOnly
 	if k.manualReadOnly != nil {
 		readOnly = *k.manualReadOnly
@@ -8,6 +8,7 @@ import (
 	pegomock "github.com/petergtz/pegomock"
 	v1 "k8s.io/api/core/v1"
 	version "k8s.io/apimachinery/pkg/version"
+	genericclioptions "k8s.io/cli-runtime/pkg/genericclioptions"
 	disk "k8s.io/client-go/discovery/cached/disk"
 	dynamic "k8s.io/client-go/dynamic"
 	kubernetes "k8s.io/client-go/kubernetes"
@@ -289,6 +290,21 @@ func (mock *MockConnection) ServerVersion() (*version.Info, error) {
 	return ret0, ret1
 }
 
+func (mock *MockConnection) RestoreContext(_param0 *genericclioptions.ConfigFlags, _param1 string) error {
+	if mock == nil {
+		panic("mock must not be nil. Use myMock := NewMockConnection().")
+	}
+	params := []pegomock.Param{_param0, _param1}
+	result := pegomock.GetGenericMockFrom(mock).Invoke("RestoreContext", params, []reflect.Type{reflect.TypeOf((*error)(nil)).Elem()})
+	var ret0 error
+	if len(result) != 0 {
+		if result[0] != nil {
+			ret0 = result[0].(error)
+		}
+	}
+	return ret0
+}
+
 func (mock *MockConnection) SwitchContext(_param0 string) error {
 	if mock == nil {
 		panic("mock must not be nil. Use myMock := NewMockConnection().")
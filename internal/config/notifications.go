@@ -0,0 +1,135 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"gopkg.in/yaml.v2"
+)
+
+// K9sNotifications manages K9s fleet notification rules.
+var K9sNotifications = filepath.Join(K9sHome(), "notifications.yml")
+
+const (
+	// NotifyCluster designates fleet cluster notification rules.
+	NotifyCluster = "Cluster"
+
+	// NotifyApplication designates fleet application notification rules.
+	NotifyApplication = "Application"
+
+	// DefaultHookTimeout tracks the default notification hook timeout.
+	DefaultHookTimeout = 10 * time.Second
+)
+
+// NotificationFields tracks the fields notification rules may watch by kind.
+var NotificationFields = map[string][]string{
+	NotifyCluster:     {"health", "activity"},
+	NotifyApplication: {"rollout", "state"},
+}
+
+// FleetNotifications represents fleet notification settings.
+type FleetNotifications struct {
+	Notifications Notifications `yaml:"notifications"`
+}
+
+// Notifications tracks fleet notification rules and an optional command hook.
+type Notifications struct {
+	// Rules lists the fleet state transitions to notify on.
+	Rules []NotificationRule `yaml:"rules"`
+
+	// Hook runs a local command for each notification with the event as JSON on stdin.
+	Hook *NotificationHook `yaml:"hook,omitempty"`
+}
+
+// NotificationRule describes a fleet state transition to notify on.
+// Blank From or To values match any state.
+type NotificationRule struct {
+	Name  string `yaml:"name"`
+	Kind  string `yaml:"kind"`
+	Field string `yaml:"field"`
+	From  string `yaml:"from,omitempty"`
+	To    string `yaml:"to,omitempty"`
+	Level string `yaml:"level,omitempty"`
+}
+
+// NotificationHook describes a local command run on notifications.
+type NotificationHook struct {
+	Command string   `yaml:"command"`
+	Args    []string `yaml:"args,omitempty"`
+	Timeout string   `yaml:"timeout,omitempty"`
+}
+
+// NewFleetNotifications returns the default fleet notification rules.
+func NewFleetNotifications() *FleetNotifications {
+	return &FleetNotifications{
+		Notifications: Notifications{
+			Rules: []NotificationRule{
+				{Name: "cluster-failed", Kind: NotifyCluster, Field: "health", To: "Failed", Level: "error"},
+				{Name: "cluster-partial-failed", Kind: NotifyCluster, Field: "health", To: "Partial-Failed", Level: "warn"},
+				{Name: "application-rolling-back", Kind: NotifyApplication, Field: "rollout", To: "RollingBack", Level: "error"},
+			},
+		},
+	}
+}
+
+// Load K9s notification rules. The default rules are kept if no rules file is found.
+func (n *FleetNotifications) Load() error {
+	return n.LoadNotifications(K9sNotifications)
+}
+
+// LoadNotifications loads notification rules from a given file.
+func (n *FleetNotifications) LoadNotifications(path string) error {
+	f, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+
+	var nn FleetNotifications
+	if err := yaml.Unmarshal(f, &nn); err != nil {
+		return err
+	}
+	for _, r := range nn.Notifications.Rules {
+		if err := r.Validate(); err != nil {
+			return err
+		}
+	}
+	n.Notifications = nn.Notifications
+
+	return nil
+}
+
+// Validate checks a rule watches a known field.
+func (r NotificationRule) Validate() error {
+	ff, ok := NotificationFields[r.Kind]
+	if !ok {
+		return fmt.Errorf("notification rule %q: unknown kind %q", r.Name, r.Kind)
+	}
+	for _, f := range ff {
+		if f == r.Field {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("notification rule %q: unknown %s field %q", r.Name, r.Kind, r.Field)
+}
+
+// Matches returns true if a field transition satisfies the rule.
+func (r NotificationRule) Matches(kind, field, from, to string) bool {
+	if r.Kind != kind || r.Field != field || from == to {
+		return false
+	}
+
+	return (r.From == "" || r.From == from) && (r.To == "" || r.To == to)
+}
+
+// HookTimeout returns the hook timeout or the default if not set.
+func (h *NotificationHook) HookTimeout() time.Duration {
+	d, err := time.ParseDuration(h.Timeout)
+	if err != nil || d <= 0 {
+		return DefaultHookTimeout
+	}
+
+	return d
+}
@@ -0,0 +1,72 @@
+package config_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNotificationsLoad(t *testing.T) {
+	n := config.NewFleetNotifications()
+	assert.Nil(t, n.LoadNotifications("testdata/notifications.yml"))
+
+	assert.Equal(t, 2, len(n.Notifications.Rules))
+	r := n.Notifications.Rules[0]
+	assert.Equal(t, "cluster-down", r.Name)
+	assert.Equal(t, config.NotifyCluster, r.Kind)
+	assert.Equal(t, "Failed", r.To)
+	assert.Equal(t, "notify-send", n.Notifications.Hook.Command)
+	assert.Equal(t, []string{"f9s"}, n.Notifications.Hook.Args)
+	assert.Equal(t, 2*time.Second, n.Notifications.Hook.HookTimeout())
+}
+
+func TestNotificationsLoadInvalid(t *testing.T) {
+	n := config.NewFleetNotifications()
+	assert.ErrorContains(t, n.LoadNotifications("testdata/notifications_bad.yml"), `unknown Cluster field "rollout"`)
+	assert.Equal(t, 3, len(n.Notifications.Rules))
+}
+
+func TestNotificationRuleMatches(t *testing.T) {
+	uu := map[string]struct {
+		r                     config.NotificationRule
+		kind, field, from, to string
+		e                     bool
+	}{
+		"any-from": {
+			r:    config.NotificationRule{Kind: config.NotifyCluster, Field: "health", To: "Failed"},
+			kind: config.NotifyCluster, field: "health", from: "Healthy", to: "Failed",
+			e: true,
+		},
+		"from": {
+			r:    config.NotificationRule{Kind: config.NotifyCluster, Field: "health", From: "Healthy", To: "Failed"},
+			kind: config.NotifyCluster, field: "health", from: "Unknown", to: "Failed",
+		},
+		"unchanged": {
+			r:    config.NotificationRule{Kind: config.NotifyCluster, Field: "health"},
+			kind: config.NotifyCluster, field: "health", from: "Failed", to: "Failed",
+		},
+		"kind": {
+			r:    config.NotificationRule{Kind: config.NotifyApplication, Field: "health", To: "Failed"},
+			kind: config.NotifyCluster, field: "health", from: "Healthy", to: "Failed",
+		},
+		"any": {
+			r:    config.NotificationRule{Kind: config.NotifyApplication, Field: "rollout"},
+			kind: config.NotifyApplication, field: "rollout", from: "Succeeded", to: "RollingOut",
+			e: true,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, u.r.Matches(u.kind, u.field, u.from, u.to))
+		})
+	}
+}
+
+func TestNotificationHookTimeout(t *testing.T) {
+	assert.Equal(t, config.DefaultHookTimeout, (&config.NotificationHook{}).HookTimeout())
+	assert.Equal(t, config.DefaultHookTimeout, (&config.NotificationHook{Timeout: "blee"}).HookTimeout())
+}
@@ -2,15 +2,24 @@ package dao
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 )
 
+const applicationGVR = "apis.clusterfleet.io/v1alpha1/applications"
+
 var (
-	_ Accessor = (*Application)(nil)
-	_ Nuker    = (*Application)(nil)
+	_ Accessor          = (*Application)(nil)
+	_ Nuker             = (*Application)(nil)
+	_ RolloutMaintainer = (*Application)(nil)
 )
 
 // CustomResourceDefinition represents a CRD resource model.
@@ -26,6 +35,107 @@ func (c *Application) List(ctx context.Context, _ string) ([]runtime.Object, err
 		labelSel = sel.AsSelector()
 	}
 
-	const gvr = "apis.clusterfleet.io/v1alpha1/applications"
-	return c.GetFactory().List(gvr, "-", false, labelSel)
+	feedFleetCache(c.GetFactory(), client.ClusterScope, applicationGVR)
+	return c.GetFactory().List(applicationGVR, "-", false, labelSel)
+}
+
+// TogglePause pauses/resumes an application rollout.
+func (c *Application) TogglePause(path string, pause bool) error {
+	app, err := FetchApplication(c.GetFactory(), path)
+	if err != nil {
+		return err
+	}
+	if app.Spec.Paused == pause {
+		if pause {
+			return fmt.Errorf("application is already paused")
+		}
+		return fmt.Errorf("application is not paused")
+	}
+
+	return c.patch(path, fmt.Sprintf(`{"spec":{"paused":%t}}`, pause))
+}
+
+// RollbackLKG rolls an application back to its last known good version.
+func (c *Application) RollbackLKG(path string) error {
+	app, err := FetchApplication(c.GetFactory(), path)
+	if err != nil {
+		return err
+	}
+	lkg, err := RollbackVersion(app)
+	if err != nil {
+		return err
+	}
+
+	return c.patch(path, fmt.Sprintf(`{"spec":{"version":%q}}`, lkg))
+}
+
+func (c *Application) patch(path, patch string) error {
+	dial, err := c.GetFactory().Client().DynDial()
+	if err != nil {
+		return err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), c.GetFactory().Client().Config().CallTimeout())
+	defer cancel()
+	ns, n := client.Namespaced(path)
+	_, err = dial.Resource(client.NewGVR(applicationGVR).GVR()).Namespace(ns).Patch(
+		ctx,
+		n,
+		types.MergePatchType,
+		[]byte(patch),
+		metav1.PatchOptions{},
+	)
+
+	return err
+}
+
+// RollbackVersion returns the last known good version an application can be rolled back to.
+func RollbackVersion(app *render.Application) (string, error) {
+	lkg := app.Status.LastKnownGoodVersion
+	if lkg == "" {
+		return "", fmt.Errorf("no last known good version for application %s", app.Name)
+	}
+	if lkg == app.Spec.Version {
+		return "", fmt.Errorf("application %s is already at last known good version %s", app.Name, lkg)
+	}
+
+	return lkg, nil
+}
+
+// FetchApplication retrieves a fleet application given its fully qualified name.
+func FetchApplication(f Factory, fqn string) (*render.Application, error) {
+	o, err := f.Get(applicationGVR, fqn, true, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	ns, _ := client.Namespaced(fqn)
+	feedFleetCache(f, ns, applicationGVR)
+	u, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return nil, fmt.Errorf("expecting unstructured but got %T", o)
+	}
+
+	return render.FleetObjects.Application(u)
+}
+
+// FetchApplications retrieves all fleet applications in a given namespace.
+func FetchApplications(f Factory, ns string) ([]render.Application, error) {
+	oo, err := f.List(applicationGVR, ns, true, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	feedFleetCache(f, ns, applicationGVR)
+	aa := make([]render.Application, 0, len(oo))
+	for _, o := range oo {
+		u, ok := o.(*unstructured.Unstructured)
+		if !ok {
+			return nil, fmt.Errorf("expecting unstructured but got %T", o)
+		}
+		app, err := render.FleetObjects.Application(u)
+		if err != nil {
+			return nil, err
+		}
+		aa = append(aa, *app)
+	}
+
+	return aa, nil
 }
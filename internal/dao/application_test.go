@@ -0,0 +1,44 @@
+package dao_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRollbackVersion(t *testing.T) {
+	uu := map[string]struct {
+		version, lkg string
+		e            string
+		err          error
+	}{
+		"rollback": {
+			version: "v2",
+			lkg:     "v1",
+			e:       "v1",
+		},
+		"no-lkg": {
+			version: "v1",
+			err:     errors.New("no last known good version for application fred"),
+		},
+		"at-lkg": {
+			version: "v1",
+			lkg:     "v1",
+			err:     errors.New("application fred is already at last known good version v1"),
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			var app render.Application
+			app.Name, app.Spec.Version, app.Status.LastKnownGoodVersion = "fred", u.version, u.lkg
+			v, err := dao.RollbackVersion(&app)
+			assert.Equal(t, u.err, err)
+			assert.Equal(t, u.e, v)
+		})
+	}
+}
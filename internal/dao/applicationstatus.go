@@ -8,7 +8,6 @@ import (
 	"github.com/derailed/k9s/internal/render"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
-	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -30,30 +29,20 @@ func (c *ApplicationStatus) List(ctx context.Context, _ string) ([]runtime.Objec
 	}
 
 	//fqn = "clusterfleet/" + fqn
-	app, err := c.fetchApplication(fqn)
+	app, err := FetchApplication(c.GetFactory(), fqn)
 	if err != nil {
 		return nil, err
 	}
 	res := make([]runtime.Object, 0, len(app.Status.Clusters))
-
+	desired := desiredManifests(app)
 	for _, w := range app.Status.Clusters {
-		res = append(res, c.makeApplicationStatusResp(w))
+		res = append(res, c.makeApplicationStatusResp(w, desired))
 	}
 
 	return res, nil
 }
 
-func (c *ApplicationStatus) fetchApplication(fqn string) (*render.Application, error) {
-	o, err := c.GetFactory().Get("apis.clusterfleet.io/v1alpha1/applications", fqn, true, labels.Everything())
-	if err != nil {
-		return nil, err
-	}
-	var app render.Application
-	err = runtime.DefaultUnstructuredConverter.FromUnstructured(o.(*unstructured.Unstructured).Object, &app)
-	return &app, err
-}
-
-func (c *ApplicationStatus) makeApplicationStatusResp(cluster render.ApplicationClusterStatus) render.ApplicationStatusRes {
+func (c *ApplicationStatus) makeApplicationStatusResp(cluster render.ApplicationClusterStatus, desired map[string]*unstructured.Unstructured) render.ApplicationStatusRes {
 	var readyCondition v1.Condition = v1.Condition{
 		Reason: "Unknown",
 	}
@@ -65,10 +54,15 @@ func (c *ApplicationStatus) makeApplicationStatusResp(cluster render.Application
 	}
 
 	ready := readyCondition.Reason
+	oo := make([]*unstructured.Unstructured, len(cluster.ManifestStatuses))
+	for i, ms := range cluster.ManifestStatuses {
+		oo[i] = desired[manifestKey(ms.Namespace, ms.Name, ms.Kind)]
+	}
 
 	return render.ApplicationStatusRes{
 		Cluster: cluster.Cluster,
 		Ready:   ready,
 		Status:  &cluster,
+		Objects: oo,
 	}
 }
@@ -0,0 +1,79 @@
+package dao
+
+import (
+	"context"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// ClusterDefinitionGVR represents the fleet cluster definitions resource.
+const ClusterDefinitionGVR = "apis.clusterfleet.io/v1alpha1/clusterdefinitions"
+
+const clusterProvisionerGVR = "apis.clusterfleet.io/v1alpha1/clusterprovisioners"
+
+var _ Accessor = (*ClusterDefinition)(nil)
+
+// ClusterDefinition represents a fleet cluster definition resource.
+type ClusterDefinition struct {
+	Resource
+}
+
+// List returns a collection of cluster definitions or the definition at the context path if any.
+func (c *ClusterDefinition) List(ctx context.Context, _ string) ([]runtime.Object, error) {
+	if path, ok := ctx.Value(internal.KeyPath).(string); ok && path != "" {
+		o, err := c.GetFactory().Get(ClusterDefinitionGVR, path, true, labels.Everything())
+		if err != nil {
+			return nil, err
+		}
+		return []runtime.Object{o}, nil
+	}
+
+	return c.Resource.List(ctx, client.ClusterScope)
+}
+
+// FetchClusterDefinition retrieves a fleet cluster definition by name.
+func FetchClusterDefinition(f Factory, name string) (*render.ClusterDefinition, error) {
+	o, err := f.Get(ClusterDefinitionGVR, client.FQN(client.ClusterScope, name), true, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	var def render.ClusterDefinition
+	err = runtime.DefaultUnstructuredConverter.FromUnstructured(o.(*unstructured.Unstructured).Object, &def)
+
+	return &def, err
+}
+
+// FetchClusterProvisioner retrieves a fleet cluster provisioner by name.
+func FetchClusterProvisioner(f Factory, name string) (*render.ClusterProvisioner, error) {
+	o, err := f.Get(clusterProvisionerGVR, client.FQN(client.ClusterScope, name), true, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	var p render.ClusterProvisioner
+	err = runtime.DefaultUnstructuredConverter.FromUnstructured(o.(*unstructured.Unstructured).Object, &p)
+
+	return &p, err
+}
+
+// ClusterDefinitionFor resolves the definition used by a fleet cluster, falling back
+// to its provisioner default definition. No definition is returned if none applies.
+func ClusterDefinitionFor(f Factory, cl *render.Cluster) (*render.ClusterDefinition, error) {
+	name := cl.Spec.ClusterDefinition
+	if name == "" && cl.Spec.Provisioner != "" {
+		p, err := FetchClusterProvisioner(f, cl.Spec.Provisioner)
+		if err != nil {
+			return nil, err
+		}
+		name = p.Spec.DefaultDefinitionName
+	}
+	if name == "" {
+		return nil, nil
+	}
+
+	return FetchClusterDefinition(f, name)
+}
@@ -0,0 +1,83 @@
+package dao
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/render"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const (
+	clusterHealthPolicyGVR = "apis.clusterfleet.io/v1alpha1/clusterhealthpolicies"
+	clusterHealthReportGVR = "apis.clusterfleet.io/v1alpha1/clusterhealthreports"
+)
+
+var _ Accessor = (*HealthPolicy)(nil)
+
+// HealthPolicy represents the policies of a cluster health policy or report.
+type HealthPolicy struct {
+	NonResource
+}
+
+// List returns a collection of health policies along with their reported status.
+func (h *HealthPolicy) List(ctx context.Context, _ string) ([]runtime.Object, error) {
+	gvr, ok := ctx.Value(internal.KeyGVR).(string)
+	if !ok {
+		return nil, fmt.Errorf("expecting a context gvr")
+	}
+	if gvr != clusterHealthPolicyGVR && gvr != clusterHealthReportGVR {
+		return nil, fmt.Errorf("expecting a cluster health policy or report but got %q", gvr)
+	}
+	fqn, ok := ctx.Value(internal.KeyPath).(string)
+	if !ok || fqn == "" {
+		return nil, fmt.Errorf("no context path for %q", h.gvr)
+	}
+
+	o, err := h.GetFactory().Get(gvr, fqn, true, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	// Policies and reports share the same shape.
+	var chr render.ClusterHealthReport
+	err = runtime.DefaultUnstructuredConverter.FromUnstructured(o.(*unstructured.Unstructured).Object, &chr)
+	if err != nil {
+		return nil, err
+	}
+
+	return healthPolicies(chr.Spec, chr.Status), nil
+}
+
+func healthPolicies(spec render.ClusterHealthPolicySpec, status render.ClusterHealthPoliciesStatus) []runtime.Object {
+	ss := make(map[string]render.HealthPolicyStatus, len(status.ClusterHealthPoliciesStatus))
+	for _, s := range status.ClusterHealthPoliciesStatus {
+		ss[s.HealthPolicyStatus.PolicyName] = s.HealthPolicyStatus
+	}
+
+	oo := make([]runtime.Object, 0, len(spec.Policies))
+	for _, p := range spec.Policies {
+		res := render.HealthPolicyRes{Spec: p.PolicySpec}
+		if s, ok := ss[p.PolicySpec.PolicyName]; ok {
+			res.Status = &s
+			delete(ss, p.PolicySpec.PolicyName)
+		}
+		oo = append(oo, res)
+	}
+	// Surface reported statuses that no longer match a declared policy.
+	for _, s := range status.ClusterHealthPoliciesStatus {
+		st, ok := ss[s.HealthPolicyStatus.PolicyName]
+		if !ok {
+			continue
+		}
+		delete(ss, st.PolicyName)
+		oo = append(oo, render.HealthPolicyRes{
+			Spec:   render.PolicySpec{PolicyName: st.PolicyName},
+			Status: &st,
+		})
+	}
+
+	return oo
+}
@@ -0,0 +1,93 @@
+package dao
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/render"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+var _ Accessor = (*ClusterManifest)(nil)
+
+// ClusterManifest represents the manifests of an application on a given fleet cluster.
+type ClusterManifest struct {
+	NonResource
+}
+
+// List returns a collection of manifest statuses for an application cluster.
+func (c *ClusterManifest) List(ctx context.Context, _ string) ([]runtime.Object, error) {
+	fqn, ok := ctx.Value(internal.KeyPath).(string)
+	if !ok {
+		return nil, fmt.Errorf("no context path for %q", c.gvr)
+	}
+	cluster, ok := ctx.Value(internal.KeyCluster).(string)
+	if !ok || cluster == "" {
+		return nil, fmt.Errorf("no cluster specified for %q", c.gvr)
+	}
+
+	app, err := FetchApplication(c.GetFactory(), fqn)
+	if err != nil {
+		return nil, err
+	}
+	status, ok := applicationClusterStatus(app, cluster)
+	if !ok {
+		return nil, fmt.Errorf("no status found for cluster %q on application %q", cluster, fqn)
+	}
+
+	desired := desiredManifests(app)
+	res := make([]runtime.Object, 0, len(status.ManifestStatuses))
+	for i := range status.ManifestStatuses {
+		ms := status.ManifestStatuses[i]
+		replicas, mo := "-", desired[manifestKey(ms.Namespace, ms.Name, ms.Kind)]
+		if mo != nil {
+			replicas = render.GetReplicaForUnstructured(mo)
+		}
+		res = append(res, render.ClusterManifestRes{
+			Cluster:   cluster,
+			Name:      ms.Name,
+			Namespace: ms.Namespace,
+			Kind:      ms.Kind,
+			Replicas:  replicas,
+			Object:    mo,
+			Status:    &ms,
+		})
+	}
+
+	return res, nil
+}
+
+func applicationClusterStatus(app *render.Application, cluster string) (render.ApplicationClusterStatus, bool) {
+	for _, cs := range app.Status.Clusters {
+		if cs.Cluster == cluster {
+			return cs, true
+		}
+	}
+
+	return render.ApplicationClusterStatus{}, false
+}
+
+// desiredManifests returns the decoded application workload manifests keyed by namespace, name and kind.
+func desiredManifests(app *render.Application) map[string]*unstructured.Unstructured {
+	desired := make(map[string]*unstructured.Unstructured, len(app.Spec.Workload))
+	for _, m := range render.FleetObjects.Manifests(app) {
+		if m.Err != nil {
+			continue
+		}
+		mo := m.Object
+		key := manifestKey(
+			render.GetNamespaceFromUnstructured(mo),
+			render.GetNameFromUnstructured(mo),
+			render.GetKindFromUnstructured(mo),
+		)
+		desired[key] = mo
+	}
+
+	return desired
+}
+
+func manifestKey(ns, n, kind string) string {
+	return fmt.Sprintf("%s.%s.%s", ns, n, kind)
+}
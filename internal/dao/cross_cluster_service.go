@@ -0,0 +1,260 @@
+package dao
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/render"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+const (
+	crossClusterServiceGVR = "apis.clusterfleet.io/v1alpha1/crossclusterservices"
+	crossClusterShardGVR   = "apis.clusterfleet.io/v1alpha1/crossclustershards"
+)
+
+var _ Accessor = (*ServiceMember)(nil)
+
+// ServiceMember represents a cross cluster service shards, selected clusters and matching pods.
+type ServiceMember struct {
+	NonResource
+}
+
+// List returns a cross cluster service members.
+func (s *ServiceMember) List(ctx context.Context, _ string) ([]runtime.Object, error) {
+	fqn, ok := ctx.Value(internal.KeyPath).(string)
+	if !ok || fqn == "" {
+		return nil, fmt.Errorf("no context path for %q", s.gvr)
+	}
+
+	o, err := s.GetFactory().Get(crossClusterServiceGVR, fqn, true, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	var ccs render.CrossClusterService
+	err = runtime.DefaultUnstructuredConverter.FromUnstructured(o.(*unstructured.Unstructured).Object, &ccs)
+	if err != nil {
+		return nil, err
+	}
+
+	shards, err := s.shards(&ccs)
+	if err != nil {
+		return nil, err
+	}
+	cc, err := FetchFleetClusters(s.GetFactory())
+	if err != nil {
+		return nil, err
+	}
+	cc = selectedClusters(ccs.Spec.ClusterSelector, cc)
+	pods, errs := s.pods(ctx, &ccs, cc)
+
+	return serviceMembers(&ccs, shards, cc, pods, errs), nil
+}
+
+func (s *ServiceMember) shards(ccs *render.CrossClusterService) ([]render.CrossClusterShard, error) {
+	oo, err := s.GetFactory().List(crossClusterShardGVR, ccs.Namespace, true, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	ss := make([]render.CrossClusterShard, 0, len(oo))
+	for _, o := range oo {
+		var shard render.CrossClusterShard
+		err := runtime.DefaultUnstructuredConverter.FromUnstructured(o.(*unstructured.Unstructured).Object, &shard)
+		if err != nil {
+			return nil, err
+		}
+		if shard.Spec.ServiceName == ccs.Name {
+			ss = append(ss, shard)
+		}
+	}
+
+	return ss, nil
+}
+
+// memberPod tracks a pod living on a fleet member cluster.
+type memberPod struct {
+	cluster string
+	pod     *unstructured.Unstructured
+}
+
+// pods returns the pods matching the service pod selectors on each selected member cluster
+// along with the clusters that could not be listed.
+func (s *ServiceMember) pods(ctx context.Context, ccs *render.CrossClusterService, cc []render.Cluster) ([]memberPod, map[string]error) {
+	if len(ccs.Spec.PodSelector) == 0 {
+		return nil, nil
+	}
+	cfg := memberContexts(ctx)
+
+	var (
+		mx   sync.Mutex
+		wg   sync.WaitGroup
+		pp   []memberPod
+		errs = make(map[string]error)
+	)
+	for _, c := range cc {
+		wg.Add(1)
+		go func(cluster string) {
+			defer wg.Done()
+			mm, err := s.memberPods(ctx, cfg, ccs, cluster)
+			mx.Lock()
+			defer mx.Unlock()
+			if err != nil {
+				errs[cluster] = err
+				return
+			}
+			pp = append(pp, mm...)
+		}(c.Name)
+	}
+	wg.Wait()
+	sort.SliceStable(pp, func(i, j int) bool {
+		if pp[i].cluster != pp[j].cluster {
+			return pp[i].cluster < pp[j].cluster
+		}
+		return pp[i].pod.GetName() < pp[j].pod.GetName()
+	})
+
+	return pp, errs
+}
+
+// memberPods lists the pods matching the service pod selectors on a member cluster.
+// Each selector is sent as its own label selector and the results are combined by pod UID.
+func (s *ServiceMember) memberPods(ctx context.Context, cfg *config.MemberContexts, ccs *render.CrossClusterService, cluster string) ([]memberPod, error) {
+	conn, err := memberConnection(s.GetFactory(), cfg, cluster)
+	if err != nil {
+		return nil, err
+	}
+	dial, err := conn.Dial()
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := context.WithTimeout(ctx, cfg.MemberTimeout())
+	defer cancel()
+	ll := make([][]v1.Pod, 0, len(ccs.Spec.PodSelector))
+	for _, sel := range ccs.Spec.PodSelector {
+		pp, err := dial.CoreV1().Pods(ccs.Namespace).List(ctx, metav1.ListOptions{
+			LabelSelector: labels.SelectorFromSet(sel).String(),
+		})
+		if err != nil {
+			return nil, err
+		}
+		ll = append(ll, pp.Items)
+	}
+
+	uu := uniquePods(ll...)
+	pp := make([]memberPod, 0, len(uu))
+	for i := range uu {
+		raw, err := runtime.DefaultUnstructuredConverter.ToUnstructured(&uu[i])
+		if err != nil {
+			return nil, err
+		}
+		pp = append(pp, memberPod{cluster: cluster, pod: &unstructured.Unstructured{Object: raw}})
+	}
+
+	return pp, nil
+}
+
+// uniquePods combines pod lists, keeping a single copy of pods matched by more than one selector.
+func uniquePods(ll ...[]v1.Pod) []v1.Pod {
+	seen := make(map[types.UID]struct{})
+	var pp []v1.Pod
+	for _, l := range ll {
+		for _, p := range l {
+			if _, ok := seen[p.UID]; ok {
+				continue
+			}
+			seen[p.UID] = struct{}{}
+			pp = append(pp, p)
+		}
+	}
+
+	return pp
+}
+
+func serviceMembers(ccs *render.CrossClusterService, ss []render.CrossClusterShard, cc []render.Cluster, pp []memberPod, errs map[string]error) []runtime.Object {
+	sharded := render.ServiceType(ccs.Spec) == render.ShardedService
+	known := make(map[string]struct{}, len(ss))
+	for _, s := range ss {
+		known[s.Name] = struct{}{}
+	}
+
+	counts := make(map[string]int, len(ss))
+	pods := make([]runtime.Object, 0, len(pp))
+	for _, mp := range pp {
+		p := mp.pod
+		m := render.ServiceMemberRes{
+			Kind:    render.PodMember,
+			Name:    client.FQN(p.GetNamespace(), p.GetName()),
+			Cluster: mp.cluster,
+			Labels:  p.GetLabels(),
+		}
+		if phase, ok, _ := unstructured.NestedString(p.Object, "status", "phase"); ok {
+			m.Status = phase
+		}
+		if sharded {
+			m.Shard, m.Err = render.ResolveShard(ccs.Spec.ShardResolver, p.GetName(), p.GetLabels())
+			if _, ok := known[m.Shard]; m.Err == nil && !ok {
+				m.Err = fmt.Errorf("no shard %q for service %s", m.Shard, ccs.Name)
+			}
+			counts[m.Shard]++
+		}
+		pods = append(pods, m)
+	}
+
+	oo := make([]runtime.Object, 0, len(ss)+len(cc)+len(pp))
+	for _, s := range ss {
+		oo = append(oo, render.ServiceMemberRes{
+			Kind:   render.ShardMember,
+			Name:   s.Name,
+			Shard:  s.Name,
+			Status: fmt.Sprintf("%d pods", counts[s.Name]),
+			Labels: s.Labels,
+		})
+	}
+	for _, c := range cc {
+		oo = append(oo, render.ServiceMemberRes{
+			Kind:    render.ClusterMember,
+			Name:    c.Name,
+			Cluster: c.Name,
+			Status:  string(c.Status.ClusterHealthStatus),
+			Labels:  c.Labels,
+			Err:     errs[c.Name],
+		})
+	}
+
+	return append(oo, pods...)
+}
+
+// selectedClusters returns the clusters matching any of the selectors. No selectors selects all clusters.
+func selectedClusters(sels []map[string]string, cc []render.Cluster) []render.Cluster {
+	if len(sels) == 0 {
+		return cc
+	}
+	mm := make([]render.Cluster, 0, len(cc))
+	for _, c := range cc {
+		if matchesAny(sels, c.Labels) {
+			mm = append(mm, c)
+		}
+	}
+
+	return mm
+}
+
+func matchesAny(sels []map[string]string, ll map[string]string) bool {
+	for _, sel := range sels {
+		if labels.SelectorFromSet(sel).Matches(labels.Set(ll)) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,88 @@
+package dao
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+func TestServiceMembers(t *testing.T) {
+	var ccs render.CrossClusterService
+	ccs.Name, ccs.Namespace = "store", "default"
+	ccs.Spec.Type = render.ShardedService
+	ccs.Spec.ShardResolver = render.ShardResolver{ResolverType: render.ByOrdinalResolver}
+
+	var s1 render.CrossClusterShard
+	s1.Name = "1"
+	cc := []render.Cluster{makeLabeledCluster("c1", nil), makeLabeledCluster("c2", nil)}
+	pp := []memberPod{
+		{cluster: "c1", pod: makeMemberPod("store-1")},
+		{cluster: "c1", pod: makeMemberPod("store-2")},
+		{cluster: "c1", pod: makeMemberPod("store")},
+	}
+	errs := map[string]error{"c2": errors.New("unable to connect")}
+
+	oo := serviceMembers(&ccs, []render.CrossClusterShard{s1}, cc, pp, errs)
+	assert.Equal(t, 6, len(oo))
+
+	e := []render.ServiceMemberRes{
+		{Kind: render.ShardMember, Name: "1", Shard: "1", Status: "1 pods"},
+		{Kind: render.ClusterMember, Name: "c1", Cluster: "c1"},
+		{Kind: render.ClusterMember, Name: "c2", Cluster: "c2", Err: errors.New("unable to connect")},
+		{Kind: render.PodMember, Name: "default/store-1", Cluster: "c1", Shard: "1", Status: "Running"},
+		{Kind: render.PodMember, Name: "default/store-2", Cluster: "c1", Shard: "2", Status: "Running", Err: errors.New(`no shard "2" for service store`)},
+		{Kind: render.PodMember, Name: "default/store", Cluster: "c1", Status: "Running", Err: errors.New(`no ordinal in pod name "store"`)},
+	}
+	for i, o := range oo {
+		m := o.(render.ServiceMemberRes)
+		m.Labels = nil
+		assert.Equal(t, e[i], m)
+	}
+}
+
+func TestSelectedClusters(t *testing.T) {
+	cc := []render.Cluster{
+		makeLabeledCluster("c1", map[string]string{"env": "prod"}),
+		makeLabeledCluster("c2", map[string]string{"env": "dev"}),
+		makeLabeledCluster("c3", map[string]string{"env": "test"}),
+	}
+
+	assert.Equal(t, 3, len(selectedClusters(nil, cc)))
+	mm := selectedClusters([]map[string]string{{"env": "prod"}, {"env": "test"}}, cc)
+	assert.Equal(t, 2, len(mm))
+	assert.Equal(t, "c1", mm[0].Name)
+	assert.Equal(t, "c3", mm[1].Name)
+}
+
+func TestUniquePods(t *testing.T) {
+	p1, p2, p3 := makePod("p1", "u1"), makePod("p2", "u2"), makePod("p3", "u3")
+	pp := uniquePods([]v1.Pod{p1, p2}, []v1.Pod{p2, p3}, nil)
+
+	assert.Equal(t, []v1.Pod{p1, p2, p3}, pp)
+}
+
+func makePod(n string, uid types.UID) v1.Pod {
+	var p v1.Pod
+	p.Namespace, p.Name, p.UID = "default", n, uid
+
+	return p
+}
+
+func makeMemberPod(n string) *unstructured.Unstructured {
+	return &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"metadata": map[string]interface{}{
+				"name":      n,
+				"namespace": "default",
+			},
+			"status": map[string]interface{}{
+				"phase": "Running",
+			},
+		},
+	}
+}
@@ -0,0 +1,245 @@
+package dao
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/render"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+var _ Accessor = (*Drift)(nil)
+
+// Drift represents application manifests drifting away on member clusters.
+type Drift struct {
+	NonResource
+}
+
+// List returns the drifted application manifests on each member cluster.
+func (d *Drift) List(ctx context.Context, _ string) ([]runtime.Object, error) {
+	fqn, ok := ctx.Value(internal.KeyPath).(string)
+	if !ok {
+		return nil, fmt.Errorf("no context path for %q", d.gvr)
+	}
+	cfg := memberContexts(ctx)
+
+	app, err := FetchApplication(d.GetFactory(), fqn)
+	if err != nil {
+		return nil, err
+	}
+	desired, res := driftManifests(app)
+
+	var (
+		mx sync.Mutex
+		wg sync.WaitGroup
+	)
+	for _, cs := range app.Status.Clusters {
+		wg.Add(1)
+		go func(cluster string) {
+			defer wg.Done()
+			dd := d.clusterDrifts(ctx, cfg, cluster, desired)
+			mx.Lock()
+			defer mx.Unlock()
+			res = append(res, dd...)
+		}(cs.Cluster)
+	}
+	wg.Wait()
+	sort.SliceStable(res, func(i, j int) bool {
+		return res[i].(*render.DriftRes).ID() < res[j].(*render.DriftRes).ID()
+	})
+
+	return res, nil
+}
+
+// Diff returns the desired and live YAML of a drifted manifest given its cluster/namespace/name_kind path.
+// The live object only shows the fields set on the desired manifest.
+func (d *Drift) Diff(ctx context.Context, path string) (string, string, error) {
+	fqn, ok := ctx.Value(internal.KeyPath).(string)
+	if !ok {
+		return "", "", fmt.Errorf("no context path for %q", d.gvr)
+	}
+	tokens := strings.SplitN(path, "/", 2)
+	if len(tokens) != 2 {
+		return "", "", fmt.Errorf("invalid drift path %q", path)
+	}
+	cluster, id := tokens[0], tokens[1]
+	cfg := memberContexts(ctx)
+
+	app, err := FetchApplication(d.GetFactory(), fqn)
+	if err != nil {
+		return "", "", err
+	}
+	mo, err := applicationManifest(app, id)
+	if err != nil {
+		return "", "", err
+	}
+	desired := render.PruneServerFields(mo)
+	desiredYAML, err := ToYAML(desired, false)
+	if err != nil {
+		return "", "", err
+	}
+
+	conn, err := memberConnection(d.GetFactory(), cfg, cluster)
+	if err != nil {
+		return "", "", err
+	}
+	mapper, err := (&RestMapper{Connection: conn}).ToRESTMapper()
+	if err != nil {
+		return "", "", err
+	}
+	live, err := liveObject(ctx, conn, mapper, cfg, mo)
+	if isMissing(err) {
+		return desiredYAML, "", nil
+	}
+	if err != nil {
+		return "", "", err
+	}
+	projected := render.ProjectFields(desired.Object, render.PruneServerFields(live).Object)
+	liveYAML, err := ToYAML(&unstructured.Unstructured{Object: projected.(map[string]interface{})}, false)
+	if err != nil {
+		return "", "", err
+	}
+
+	return desiredYAML, liveYAML, nil
+}
+
+func (d *Drift) clusterDrifts(ctx context.Context, cfg *config.MemberContexts, cluster string, desired []*unstructured.Unstructured) []runtime.Object {
+	conn, err := memberConnection(d.GetFactory(), cfg, cluster)
+	if err != nil {
+		return []runtime.Object{&render.DriftRes{Cluster: cluster, Status: render.UnreachableStatus, Err: err}}
+	}
+	mapper, err := (&RestMapper{Connection: conn}).ToRESTMapper()
+	if err != nil {
+		return []runtime.Object{&render.DriftRes{Cluster: cluster, Status: render.UnreachableStatus, Err: err}}
+	}
+
+	oo := make([]runtime.Object, 0, len(desired))
+	for _, mo := range desired {
+		res := render.DriftRes{
+			Cluster:   cluster,
+			Namespace: render.GetNamespaceFromUnstructured(mo),
+			Name:      render.GetNameFromUnstructured(mo),
+			Kind:      render.GetKindFromUnstructured(mo),
+		}
+		live, err := liveObject(ctx, conn, mapper, cfg, mo)
+		switch {
+		case isMissing(err):
+			res.Status, res.Err = render.MissingStatus, err
+		case err != nil:
+			res.Status, res.Err = render.UnreachableStatus, err
+		default:
+			if res.Paths = render.ManifestDrift(mo, live); len(res.Paths) == 0 {
+				continue
+			}
+			res.Status = render.DriftedStatus
+		}
+		oo = append(oo, &res)
+	}
+
+	return oo
+}
+
+// LiveObject represents the live counterpart of a desired manifest on a member cluster.
+type LiveObject struct {
+	// Object tracks the live object, nil when the lookup failed.
+	Object *unstructured.Unstructured
+
+	// Missing indicates the object or its resource type does not exist on the cluster.
+	Missing bool
+
+	// Err tracks the lookup error if any.
+	Err error
+}
+
+// FetchLiveObjects fetches the live counterparts of desired manifests on a member cluster.
+// The returned objects are aligned with the desired manifests.
+func FetchLiveObjects(ctx context.Context, f Factory, cluster string, desired []*unstructured.Unstructured) ([]LiveObject, error) {
+	cfg := memberContexts(ctx)
+	conn, err := memberConnection(f, cfg, cluster)
+	if err != nil {
+		return nil, err
+	}
+	mapper, err := (&RestMapper{Connection: conn}).ToRESTMapper()
+	if err != nil {
+		return nil, err
+	}
+
+	oo := make([]LiveObject, 0, len(desired))
+	for _, mo := range desired {
+		live, err := liveObject(ctx, conn, mapper, cfg, mo)
+		oo = append(oo, LiveObject{Object: live, Missing: isMissing(err), Err: err})
+	}
+
+	return oo, nil
+}
+
+// driftManifests returns the decoded application manifests to diff and error rows for the undecodable ones.
+func driftManifests(app *render.Application) ([]*unstructured.Unstructured, []runtime.Object) {
+	var (
+		desired = make([]*unstructured.Unstructured, 0, len(app.Spec.Workload))
+		res     = make([]runtime.Object, 0, len(app.Status.Clusters))
+	)
+	for i, m := range render.FleetObjects.Manifests(app) {
+		if m.Err != nil {
+			res = append(res, &render.DriftRes{
+				Name:   fmt.Sprintf("manifest-%d", i),
+				Status: render.ManifestInvalidStatus,
+				Err:    m.Err,
+			})
+			continue
+		}
+		desired = append(desired, m.Object)
+	}
+
+	return desired, res
+}
+
+// isMissing checks if a live object lookup failed because the object or its resource type does not exist.
+func isMissing(err error) bool {
+	return errors.IsNotFound(err) || meta.IsNoMatchError(err)
+}
+
+// liveObject fetches the live counterpart of a desired manifest on a member cluster.
+func liveObject(ctx context.Context, conn client.Connection, mapper meta.RESTMapper, cfg *config.MemberContexts, mo *unstructured.Unstructured) (*unstructured.Unstructured, error) {
+	gvk := mo.GroupVersionKind()
+	mapping, err := mapper.RESTMapping(gvk.GroupKind(), gvk.Version)
+	if err != nil {
+		return nil, err
+	}
+	dial, err := conn.DynDial()
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, cfg.MemberTimeout())
+	defer cancel()
+	if mapping.Scope.Name() == meta.RESTScopeNameNamespace {
+		ns := mo.GetNamespace()
+		if ns == "" {
+			ns = client.DefaultNamespace
+		}
+		return dial.Resource(mapping.Resource).Namespace(ns).Get(ctx, mo.GetName(), metav1.GetOptions{})
+	}
+
+	return dial.Resource(mapping.Resource).Get(ctx, mo.GetName(), metav1.GetOptions{})
+}
+
+// memberContexts returns the member contexts configuration carried by a context or the defaults.
+func memberContexts(ctx context.Context) *config.MemberContexts {
+	cfg, ok := ctx.Value(internal.KeyMembers).(*config.MemberContexts)
+	if !ok || cfg == nil {
+		return config.NewMemberContexts()
+	}
+
+	return cfg
+}
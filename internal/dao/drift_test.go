@@ -0,0 +1,59 @@
+package dao
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+func TestIsMissing(t *testing.T) {
+	uu := map[string]struct {
+		err error
+		e   bool
+	}{
+		"none": {},
+		"not-found": {
+			err: kerrors.NewNotFound(schema.GroupResource{Resource: "deployments"}, "fred"),
+			e:   true,
+		},
+		"no-match": {
+			err: &meta.NoKindMatchError{GroupKind: schema.GroupKind{Group: "cert-manager.io", Kind: "Certificate"}},
+			e:   true,
+		},
+		"unreachable": {
+			err: errors.New("connection refused"),
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, isMissing(u.err))
+		})
+	}
+}
+
+func TestDriftManifests(t *testing.T) {
+	var app render.Application
+	app.Name, app.Namespace, app.UID = "fred", "default", "drift-manifests"
+	app.Spec.Workload = []render.ManifestWithStrategy{
+		{ManifestItem: render.Manifest{RawExtension: runtime.RawExtension{Raw: []byte(`{"apiVersion":"apps/v1","kind":"Deployment","metadata":{"name":"blee","namespace":"default"}}`)}}},
+		{ManifestItem: render.Manifest{RawExtension: runtime.RawExtension{Raw: []byte(`{"kind":`)}}},
+	}
+
+	desired, oo := driftManifests(&app)
+	assert.Equal(t, 1, len(desired))
+	assert.Equal(t, "blee", desired[0].GetName())
+	assert.Equal(t, 1, len(oo))
+
+	bad := oo[0].(*render.DriftRes)
+	assert.Equal(t, "manifest-1", bad.Name)
+	assert.Equal(t, render.ManifestInvalidStatus, bad.Status)
+	assert.NotNil(t, bad.Err)
+}
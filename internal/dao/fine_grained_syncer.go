@@ -0,0 +1,165 @@
+package dao
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const fineGrainedSyncerGVR = "apis.clusterfleet.io/v1alpha1/finegrainedsyncers"
+
+var _ Accessor = (*SyncerMember)(nil)
+
+// SyncerMember represents a fine grained syncer selected applications and followed resources.
+type SyncerMember struct {
+	NonResource
+}
+
+// followed tracks the instances of a fine grained syncer followed resource.
+type followed struct {
+	follow, kind string
+	instances    []*unstructured.Unstructured
+	err          error
+}
+
+// List returns a fine grained syncer selected applications and the matching instances of its followed resources.
+func (s *SyncerMember) List(ctx context.Context, _ string) ([]runtime.Object, error) {
+	fqn, ok := ctx.Value(internal.KeyPath).(string)
+	if !ok || fqn == "" {
+		return nil, fmt.Errorf("no context path for %q", s.gvr)
+	}
+
+	o, err := s.GetFactory().Get(fineGrainedSyncerGVR, fqn, true, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	var fgs render.FineGrainedSyncer
+	err = runtime.DefaultUnstructuredConverter.FromUnstructured(o.(*unstructured.Unstructured).Object, &fgs)
+	if err != nil {
+		return nil, err
+	}
+
+	aa, err := FetchApplications(s.GetFactory(), fgs.Namespace)
+	if err != nil {
+		return nil, err
+	}
+	ff := make([]followed, 0, len(fgs.Spec.Follow))
+	for _, f := range fgs.Spec.Follow {
+		ff = append(ff, s.follow(&fgs, f))
+	}
+
+	return syncerMembers(&fgs, aa, ff), nil
+}
+
+// follow lists a followed resource instances matching the syncer selector.
+func (s *SyncerMember) follow(fgs *render.FineGrainedSyncer, follow string) followed {
+	f := followed{follow: follow, kind: follow}
+	gvr, meta, err := ResolveFleetResource(follow)
+	if err != nil {
+		f.err = err
+		return f
+	}
+	f.kind = meta.Kind
+	ns := client.ClusterScope
+	if meta.Namespaced {
+		ns = fgs.Namespace
+	}
+	oo, err := s.GetFactory().List(gvr.String(), ns, true, syncerSelector(fgs))
+	if err != nil {
+		f.err = err
+		return f
+	}
+	for _, o := range oo {
+		u, ok := o.(*unstructured.Unstructured)
+		if !ok {
+			f.err = fmt.Errorf("expecting unstructured but got %T", o)
+			return f
+		}
+		f.instances = append(f.instances, u)
+	}
+
+	return f
+}
+
+// ResolveFleetResource resolves a fleet resource given its resource name, singular name, kind, short name or gvr.
+func ResolveFleetResource(name string) (client.GVR, metav1.APIResource, error) {
+	for _, gvr := range MetaAccess.AllGVRs() {
+		if gvr.G() != FleetGroup {
+			continue
+		}
+		meta, err := MetaAccess.MetaFor(gvr)
+		if err != nil {
+			continue
+		}
+		if gvr.String() == name || meta.Name == name || strings.EqualFold(meta.SingularName, name) || strings.EqualFold(meta.Kind, name) {
+			return gvr, meta, nil
+		}
+		for _, s := range meta.ShortNames {
+			if s == name {
+				return gvr, meta, nil
+			}
+		}
+	}
+
+	return client.GVR{}, metav1.APIResource{}, fmt.Errorf("unknown fleet resource %q", name)
+}
+
+func syncerMembers(fgs *render.FineGrainedSyncer, aa []render.Application, ff []followed) []runtime.Object {
+	sel := syncerSelector(fgs)
+	oo := make([]runtime.Object, 0, len(aa)+len(ff))
+	var selected int
+	for i := range aa {
+		if !sel.Matches(labels.Set(aa[i].Labels)) {
+			continue
+		}
+		selected++
+		m := render.SyncerMemberRes{
+			Kind:   render.SyncerApplication,
+			Name:   client.FQN(aa[i].Namespace, aa[i].Name),
+			Labels: aa[i].Labels,
+		}
+		m.State, m.Err = render.ApplicationSyncState(&aa[i])
+		oo = append(oo, m)
+	}
+
+	for _, f := range ff {
+		if f.err != nil {
+			oo = append(oo, render.SyncerMemberRes{Kind: f.kind, Follow: f.follow, State: render.UnresolvedState, Err: f.err})
+			continue
+		}
+		if len(f.instances) == 0 {
+			m := render.SyncerMemberRes{Kind: f.kind, Follow: f.follow, State: render.MissingState}
+			if selected > 0 {
+				m.Err = fmt.Errorf("no %s matching selector for %d applications", f.kind, selected)
+			}
+			oo = append(oo, m)
+			continue
+		}
+		for _, u := range f.instances {
+			m := render.SyncerMemberRes{
+				Kind:   f.kind,
+				Name:   client.FQN(u.GetNamespace(), u.GetName()),
+				Follow: f.follow,
+				Labels: u.GetLabels(),
+			}
+			m.State, m.Err = render.SyncState(u)
+			oo = append(oo, m)
+		}
+	}
+
+	return oo
+}
+
+// syncerSelector returns a fine grained syncer selector.
+// Like the other fleet selectors, an empty selector selects everything.
+func syncerSelector(fgs *render.FineGrainedSyncer) labels.Selector {
+	return labels.SelectorFromSet(fgs.Spec.Selector)
+}
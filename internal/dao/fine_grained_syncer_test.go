@@ -0,0 +1,85 @@
+package dao
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestSyncerMembers(t *testing.T) {
+	var fgs render.FineGrainedSyncer
+	fgs.Name, fgs.Namespace = "fgs", "default"
+	fgs.Spec.Selector = map[string]string{"app": "store"}
+
+	var a1, a2 render.Application
+	a1.Name, a1.Namespace, a1.Labels = "store", "default", map[string]string{"app": "store"}
+	a1.Status.ApplicationState = render.ApplicationAvailable
+	a2.Name, a2.Namespace, a2.Labels = "blog", "default", map[string]string{"app": "blog"}
+
+	ff := []followed{
+		{follow: "ccs", kind: "CrossClusterService", instances: []*unstructured.Unstructured{makeFollowed("store-svc", 2, 1)}},
+		{follow: "fdb", kind: "FleetDisruption"},
+		{follow: "blee", kind: "blee", err: errors.New(`unknown fleet resource "blee"`)},
+	}
+
+	oo := syncerMembers(&fgs, []render.Application{a1, a2}, ff)
+	assert.Equal(t, 4, len(oo))
+
+	e := []render.SyncerMemberRes{
+		{Kind: render.SyncerApplication, Name: "default/store", State: "Available"},
+		{Kind: "CrossClusterService", Name: "default/store-svc", Follow: "ccs", State: render.OutOfSyncState, Err: errors.New("generation 2 not observed yet (1)")},
+		{Kind: "FleetDisruption", Follow: "fdb", State: render.MissingState, Err: errors.New("no FleetDisruption matching selector for 1 applications")},
+		{Kind: "blee", Follow: "blee", State: render.UnresolvedState, Err: errors.New(`unknown fleet resource "blee"`)},
+	}
+	for i, o := range oo {
+		m := o.(render.SyncerMemberRes)
+		m.Labels = nil
+		assert.Equal(t, e[i], m)
+	}
+}
+
+func TestSyncerMembersNoSelector(t *testing.T) {
+	var fgs render.FineGrainedSyncer
+	fgs.Name, fgs.Namespace = "fgs", "default"
+
+	var a1 render.Application
+	a1.Name, a1.Namespace, a1.Labels = "store", "default", map[string]string{"app": "store"}
+
+	ff := []followed{
+		{follow: "fdb", kind: "FleetDisruption"},
+		{follow: "blee", kind: "blee", err: errors.New(`unknown fleet resource "blee"`)},
+	}
+
+	oo := syncerMembers(&fgs, []render.Application{a1}, ff)
+	assert.Equal(t, 3, len(oo))
+
+	e := []render.SyncerMemberRes{
+		{Kind: render.SyncerApplication, Name: "default/store", State: render.UnknownValue},
+		{Kind: "FleetDisruption", Follow: "fdb", State: render.MissingState, Err: errors.New("no FleetDisruption matching selector for 1 applications")},
+		{Kind: "blee", Follow: "blee", State: render.UnresolvedState, Err: errors.New(`unknown fleet resource "blee"`)},
+	}
+	for i, o := range oo {
+		m := o.(render.SyncerMemberRes)
+		m.Labels = nil
+		assert.Equal(t, e[i], m)
+	}
+}
+
+func makeFollowed(n string, gen, observed int64) *unstructured.Unstructured {
+	return &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"metadata": map[string]interface{}{
+				"name":       n,
+				"namespace":  "default",
+				"generation": gen,
+				"labels":     map[string]interface{}{"app": "store"},
+			},
+			"status": map[string]interface{}{
+				"observedGeneration": observed,
+			},
+		},
+	}
+}
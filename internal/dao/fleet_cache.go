@@ -0,0 +1,50 @@
+package dao
+
+import (
+	"sync"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/rs/zerolog/log"
+	"k8s.io/client-go/tools/cache"
+)
+
+// fleetFeeds tracks the informers already feeding the fleet objects cache.
+var fleetFeeds = struct {
+	sync.Mutex
+	informers map[cache.SharedIndexInformer]struct{}
+}{informers: make(map[cache.SharedIndexInformer]struct{})}
+
+// feedFleetCache keeps the typed fleet objects cache in sync with a fleet resource informer.
+// Objects are decoded as soon as they change and evicted once deleted.
+func feedFleetCache(f Factory, ns, gvr string) {
+	inf, err := f.ForResource(ns, gvr)
+	if err != nil || inf == nil {
+		return
+	}
+	i := inf.Informer()
+
+	fleetFeeds.Lock()
+	defer fleetFeeds.Unlock()
+	if _, ok := fleetFeeds.informers[i]; ok {
+		return
+	}
+	_, err = i.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    render.FleetObjects.Warm,
+		UpdateFunc: func(_, o interface{}) { render.FleetObjects.Warm(o) },
+		DeleteFunc: render.FleetObjects.Evict,
+	})
+	if err != nil {
+		log.Warn().Err(err).Msgf("Fleet cache feed failed for %q", gvr)
+		return
+	}
+	fleetFeeds.informers[i] = struct{}{}
+}
+
+// ResetFleetCache clears out the fleet objects cache and forgets the informers feeding it.
+// It must be called whenever the factory is terminated as its informers are discarded.
+func ResetFleetCache() {
+	fleetFeeds.Lock()
+	defer fleetFeeds.Unlock()
+	fleetFeeds.informers = make(map[cache.SharedIndexInformer]struct{})
+	render.FleetObjects.Clear()
+}
@@ -1,16 +1,31 @@
 package dao
 
 import (
+	"bytes"
 	"context"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"text/tabwriter"
 
 	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 )
 
+const fleetClusterGVR = "apis.clusterfleet.io/v1alpha1/clusters"
+
 var (
-	_ Accessor = (*Application)(nil)
-	_ Nuker    = (*Application)(nil)
+	_ Accessor               = (*Application)(nil)
+	_ Nuker                  = (*Application)(nil)
+	_ Detailer               = (*FleetClusters)(nil)
+	_ FleetClusterMaintainer = (*FleetClusters)(nil)
 )
 
 // CustomResourceDefinition represents a CRD resource model.
@@ -27,5 +42,198 @@ func (c *FleetClusters) List(ctx context.Context, _ string) ([]runtime.Object, e
 	}
 
 	const gvr = "apis.clusterfleet.io/v1alpha1/clusters"
+	feedFleetCache(c.GetFactory(), client.ClusterScope, gvr)
 	return c.GetFactory().List(gvr, "-", false, labelSel)
 }
+
+// Detail returns a fleet cluster runtime status report.
+func (c *FleetClusters) Detail(path string) (string, error) {
+	cl, err := FetchFleetCluster(c.GetFactory(), path)
+	if err != nil {
+		return "", err
+	}
+
+	var buff bytes.Buffer
+	writeClusterDetail(&buff, cl)
+
+	return buff.String(), nil
+}
+
+// ToggleDrain toggles drain/activate a fleet cluster.
+func (c *FleetClusters) ToggleDrain(path string, drain bool) error {
+	cl, err := FetchFleetCluster(c.GetFactory(), path)
+	if err != nil {
+		return err
+	}
+	// An unset activity is considered active.
+	current, activity := cl.Status.ClusterActivityStatus, render.ActiveClusterActivity
+	if current == "" {
+		current = render.ActiveClusterActivity
+	}
+	if drain {
+		activity = render.DrainClusterActivity
+	}
+	if current == activity {
+		if drain {
+			return fmt.Errorf("cluster is already drained")
+		}
+		return fmt.Errorf("cluster is already active")
+	}
+
+	dial, err := c.GetFactory().Client().DynDial()
+	if err != nil {
+		return err
+	}
+	patch := fmt.Sprintf(`{"status":{"clusterActivityStatus":%q}}`, activity)
+	ctx, cancel := context.WithTimeout(context.Background(), c.GetFactory().Client().Config().CallTimeout())
+	defer cancel()
+	_, err = dial.Resource(client.NewGVR(fleetClusterGVR).GVR()).Patch(
+		ctx,
+		cl.Name,
+		types.MergePatchType,
+		[]byte(patch),
+		metav1.PatchOptions{},
+		"status",
+	)
+
+	return err
+}
+
+// ScheduledApplications returns the applications scheduled on a fleet cluster.
+func (c *FleetClusters) ScheduledApplications(path string) ([]string, error) {
+	aa, err := FetchApplications(c.GetFactory(), client.AllNamespaces)
+	if err != nil {
+		return nil, err
+	}
+	_, n := client.Namespaced(path)
+
+	return clusterApplications(aa, n), nil
+}
+
+// clusterApplications returns the sorted names of the applications scheduled on a cluster.
+func clusterApplications(aa []render.Application, cluster string) []string {
+	nn := make([]string, 0, len(aa))
+	for i := range aa {
+		if _, ok := applicationClusterStatus(&aa[i], cluster); ok {
+			nn = append(nn, client.FQN(aa[i].Namespace, aa[i].Name))
+		}
+	}
+	sort.Strings(nn)
+
+	return nn
+}
+
+// FetchFleetCluster retrieves a fleet cluster given its path.
+func FetchFleetCluster(f Factory, path string) (*render.Cluster, error) {
+	o, err := f.Get(fleetClusterGVR, path, true, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	feedFleetCache(f, client.ClusterScope, fleetClusterGVR)
+	u, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return nil, fmt.Errorf("expecting unstructured but got %T", o)
+	}
+
+	return render.FleetObjects.Cluster(u)
+}
+
+func writeClusterDetail(w io.Writer, cl *render.Cluster) {
+	rs := cl.Status.RuntimeStatus
+	fmt.Fprintf(w, "Name: %s\n", cl.Name)
+	fmt.Fprintf(w, "Provisioner: %s\n", render.NA(cl.Spec.Provisioner))
+	fmt.Fprintf(w, "ClusterDefinition: %s\n", render.NA(cl.Spec.ClusterDefinition))
+	fmt.Fprintf(w, "Health: %s\n", render.NA(string(cl.Status.ClusterHealthStatus)))
+	fmt.Fprintf(w, "HealthPolicy: %s\n", render.NA(cl.Status.ClusterHealthPolicy))
+	fmt.Fprintf(w, "Activity: %s\n", render.NA(string(cl.Status.ClusterActivityStatus)))
+	fmt.Fprintf(w, "State: %s\n", render.NA(string(rs.ClusterState)))
+	fmt.Fprintf(w, "Substate: %s\n", render.NA(string(rs.ClusterSubstate)))
+	fmt.Fprintf(w, "StateOverride: %t\n", rs.ClusterStateOverride)
+	fmt.Fprintf(w, "LastClusterStateChangeTime: %s\n", render.NA(rs.LastClusterStateChangeTime))
+	fmt.Fprintf(w, "LastStatusChange: %s\n", render.NA(cl.Status.LastStatusChange))
+
+	fmt.Fprintln(w, "Nodes:")
+	fmt.Fprintf(w, "  Healthy: %d\n", rs.NodeStatus.HealthyNodeCount)
+	fmt.Fprintf(w, "  Unhealthy: %d\n", rs.NodeStatus.TotalNodeCount-rs.NodeStatus.HealthyNodeCount)
+	fmt.Fprintf(w, "  Total: %d\n", rs.NodeStatus.TotalNodeCount)
+
+	fmt.Fprintln(w, "Versions:")
+	fmt.Fprintf(w, "  ControlPlane: %s\n", render.NA(rs.ClusterVersion.ControlPlaneVersion))
+	fmt.Fprintln(w, "  Nodes:")
+	vv := make([]string, 0, len(rs.ClusterVersion.NodeVersions))
+	for v := range rs.ClusterVersion.NodeVersions {
+		vv = append(vv, v)
+	}
+	sort.Strings(vv)
+	for _, v := range vv {
+		fmt.Fprintf(w, "    %s: %d\n", v, rs.ClusterVersion.NodeVersions[v])
+	}
+
+	fmt.Fprintln(w, "OsDistribution:")
+	fmt.Fprintf(w, "  Os: %s\n", render.NA(rs.OsDistribution.Os))
+	fmt.Fprintf(w, "  Type: %s\n", render.NA(rs.OsDistribution.Type))
+	fmt.Fprintf(w, "  VersionName: %s\n", render.NA(rs.OsDistribution.VersionName))
+	fmt.Fprintf(w, "  NodeCount: %d\n", rs.OsDistribution.NodeCount)
+
+	fmt.Fprintln(w, "Capabilities:")
+	fmt.Fprintf(w, "  LastTransitionTime: %s\n", render.NA(rs.LastCapabilitiesStatusTransitionTime))
+	writeCapabilityMatrix(w, rs)
+}
+
+// writeCapabilityMatrix dumps capabilities status overall and per OS.
+func writeCapabilityMatrix(w io.Writer, rs render.RuntimeStatus) {
+	oss := make([]string, 0, len(rs.ClusterCapabilitiesStatusPerOs))
+	for os := range rs.ClusterCapabilitiesStatusPerOs {
+		oss = append(oss, string(os))
+	}
+	sort.Strings(oss)
+
+	set := make(map[render.ComponentCapability]struct{}, len(rs.ClusterCapabilitiesStatus))
+	for c := range rs.ClusterCapabilitiesStatus {
+		set[c] = struct{}{}
+	}
+	for _, cc := range rs.ClusterCapabilitiesStatusPerOs {
+		for c := range cc {
+			set[c] = struct{}{}
+		}
+	}
+	caps := make([]string, 0, len(set))
+	for c := range set {
+		caps = append(caps, string(c))
+	}
+	sort.Strings(caps)
+	if len(caps) == 0 {
+		return
+	}
+
+	fmt.Fprintln(w)
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	hh := append([]string{"  CAPABILITY", "OVERALL"}, upperAll(oss)...)
+	fmt.Fprintln(tw, strings.Join(hh, "\t"))
+	for _, c := range caps {
+		row := []string{"  " + c, policyStatus(rs.ClusterCapabilitiesStatus, render.ComponentCapability(c))}
+		for _, os := range oss {
+			row = append(row, policyStatus(rs.ClusterCapabilitiesStatusPerOs[render.OperatingSystem(os)], render.ComponentCapability(c)))
+		}
+		fmt.Fprintln(tw, strings.Join(row, "\t"))
+	}
+	_ = tw.Flush()
+}
+
+func policyStatus(m map[render.ComponentCapability]render.PolicyStatus, c render.ComponentCapability) string {
+	s, ok := m[c]
+	if !ok {
+		return render.MissingValue
+	}
+
+	return string(s)
+}
+
+func upperAll(ss []string) []string {
+	uu := make([]string, 0, len(ss))
+	for _, s := range ss {
+		uu = append(uu, strings.ToUpper(s))
+	}
+
+	return uu
+}
@@ -0,0 +1,69 @@
+package dao
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWriteCapabilityMatrix(t *testing.T) {
+	rs := render.RuntimeStatus{
+		ClusterCapabilitiesStatus: map[render.ComponentCapability]render.PolicyStatus{
+			render.ComponentCapabilityInfra:   render.PolicyStatusHealthy,
+			render.ComponentCapabilityIngress: render.PolicyStatusPartialHealthy,
+		},
+		ClusterCapabilitiesStatusPerOs: map[render.OperatingSystem]map[render.ComponentCapability]render.PolicyStatus{
+			render.OperatingSystemLinux: {
+				render.ComponentCapabilityInfra:   render.PolicyStatusHealthy,
+				render.ComponentCapabilityIngress: render.PolicyStatusUnhealthy,
+			},
+			render.OperatingSystemWindows: {
+				render.ComponentCapabilityInfra: render.PolicyStatusNotApplicable,
+				render.ComponentCapabilityLogs:  render.PolicyStatusHealthy,
+			},
+		},
+	}
+
+	var buff bytes.Buffer
+	writeCapabilityMatrix(&buff, rs)
+
+	e := `
+  CAPABILITY  OVERALL         LINUX      WINDOWS
+  Infra       Healthy         Healthy    NotApplicable
+  Ingress     PartialHealthy  Unhealthy  <none>
+  Logs        <none>          <none>     Healthy
+`
+	assert.Equal(t, e, buff.String())
+}
+
+func TestWriteCapabilityMatrixEmpty(t *testing.T) {
+	var buff bytes.Buffer
+	writeCapabilityMatrix(&buff, render.RuntimeStatus{})
+
+	assert.Equal(t, "", buff.String())
+}
+
+func TestClusterApplications(t *testing.T) {
+	aa := []render.Application{
+		makeFleetApp("ns2", "b", "c1", "c2"),
+		makeFleetApp("ns1", "a", "c1"),
+		makeFleetApp("ns1", "c", "c2"),
+	}
+
+	assert.Equal(t, []string{"ns1/a", "ns2/b"}, clusterApplications(aa, "c1"))
+	assert.Equal(t, []string{}, clusterApplications(aa, "c3"))
+}
+
+// Helpers...
+
+func makeFleetApp(ns, n string, cc ...string) render.Application {
+	var app render.Application
+	app.Namespace, app.Name = ns, n
+	for _, c := range cc {
+		app.Status.Clusters = append(app.Status.Clusters, render.ApplicationClusterStatus{Cluster: c})
+	}
+
+	return app
+}
@@ -0,0 +1,87 @@
+package dao
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const fleetDisruptionGVR = "apis.clusterfleet.io/v1alpha1/fleetdisruptions"
+
+var _ Accessor = (*FleetDisruption)(nil)
+
+// FleetDisruption represents a fleet disruption budget resource.
+type FleetDisruption struct {
+	Resource
+}
+
+// List returns a collection of fleet disruption budgets along with their matching clusters.
+func (f *FleetDisruption) List(ctx context.Context, ns string) ([]runtime.Object, error) {
+	oo, err := f.Resource.List(ctx, ns)
+	if err != nil {
+		return nil, err
+	}
+	cc, err := FetchFleetClusters(f.GetFactory())
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]runtime.Object, 0, len(oo))
+	for _, o := range oo {
+		u, ok := o.(*unstructured.Unstructured)
+		if !ok {
+			return nil, fmt.Errorf("expecting unstructured but got %T", o)
+		}
+		sel, _, err := unstructured.NestedStringMap(u.Object, "spec", "clusterSelector")
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, &render.FleetDisruptionWithClusters{
+			Raw:      u,
+			Clusters: matchingClusters(sel, cc),
+		})
+	}
+
+	return res, nil
+}
+
+// FetchFleetClusters retrieves all fleet clusters.
+func FetchFleetClusters(f Factory) ([]render.Cluster, error) {
+	oo, err := f.List(fleetClusterGVR, client.ClusterScope, true, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	feedFleetCache(f, client.ClusterScope, fleetClusterGVR)
+	cc := make([]render.Cluster, 0, len(oo))
+	for _, o := range oo {
+		u, ok := o.(*unstructured.Unstructured)
+		if !ok {
+			return nil, fmt.Errorf("expecting unstructured but got %T", o)
+		}
+		cl, err := render.FleetObjects.Cluster(u)
+		if err != nil {
+			return nil, err
+		}
+		cc = append(cc, *cl)
+	}
+
+	return cc, nil
+}
+
+// matchingClusters returns the clusters matching a label selector. An empty selector matches all clusters.
+func matchingClusters(sel map[string]string, cc []render.Cluster) []render.Cluster {
+	s := labels.SelectorFromSet(sel)
+	mm := make([]render.Cluster, 0, len(cc))
+	for _, c := range cc {
+		if s.Matches(labels.Set(c.Labels)) {
+			mm = append(mm, c)
+		}
+	}
+
+	return mm
+}
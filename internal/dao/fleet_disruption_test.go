@@ -0,0 +1,28 @@
+package dao
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMatchingClusters(t *testing.T) {
+	cc := []render.Cluster{
+		makeLabeledCluster("c1", map[string]string{"region": "east", "env": "prod"}),
+		makeLabeledCluster("c2", map[string]string{"region": "west"}),
+		makeLabeledCluster("c3", nil),
+	}
+
+	assert.Equal(t, 1, len(matchingClusters(map[string]string{"region": "east"}, cc)))
+	assert.Equal(t, "c1", matchingClusters(map[string]string{"region": "east"}, cc)[0].Name)
+	assert.Equal(t, 0, len(matchingClusters(map[string]string{"region": "north"}, cc)))
+	assert.Equal(t, 3, len(matchingClusters(nil, cc)))
+}
+
+func makeLabeledCluster(n string, ll map[string]string) render.Cluster {
+	var cl render.Cluster
+	cl.Name, cl.Labels = n, ll
+
+	return cl
+}
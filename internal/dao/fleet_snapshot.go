@@ -0,0 +1,377 @@
+package dao
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
+	"github.com/rs/zerolog/log"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	// FleetGroup represents the fleet api group.
+	FleetGroup = "apis.clusterfleet.io"
+
+	// RedactedValue represents a value scrubbed from a snapshot.
+	RedactedValue = "<redacted>"
+
+	// SnapshotTimeout bounds a fleet snapshot export.
+	SnapshotTimeout = 2 * time.Minute
+
+	lastAppliedAnnotation = "kubectl.kubernetes.io/last-applied-configuration"
+)
+
+var crdGVR = schema.GroupVersionResource{Group: "apiextensions.k8s.io", Version: "v1", Resource: "customresourcedefinitions"}
+
+// snapshotEntry represents a snapshot tarball file.
+type snapshotEntry struct {
+	name string
+	oo   []unstructured.Unstructured
+}
+
+// Redactor scrubs sensitive data from snapshot objects.
+type Redactor struct {
+	keepSecretData bool
+	properties     []*regexp.Regexp
+}
+
+// NewRedactor returns a new snapshot redactor.
+func NewRedactor(cfg *config.FleetSnapshot) (*Redactor, error) {
+	rr, err := cfg.PropertyRedactors()
+	if err != nil {
+		return nil, err
+	}
+
+	return &Redactor{keepSecretData: cfg.KeepSecretData, properties: rr}, nil
+}
+
+// Redact scrubs Secret data, including Secrets embedded in application and manifest work
+// workloads, and matching fleet cluster properties.
+func (r *Redactor) Redact(o *unstructured.Unstructured) {
+	gvk := o.GroupVersionKind()
+	switch {
+	case gvk.Group == "" && gvk.Kind == "Secret" && !r.keepSecretData:
+		r.redactSecret(o)
+	case gvk.Group == FleetGroup && gvk.Kind == "Cluster":
+		r.redactCluster(o)
+	case gvk.Group == FleetGroup && gvk.Kind == "Application" && !r.keepSecretData:
+		r.redactWorkload(o, "manifest", "spec", "workload")
+	case gvk.Group == FleetGroup && gvk.Kind == "ManifestWork" && !r.keepSecretData:
+		r.redactWorkload(o, "", "spec", "workload", "manifests")
+	}
+}
+
+// redactWorkload scrubs the Secrets embedded in a workload manifests slice. Each slice item
+// either is the manifest or carries it under a given key.
+func (r *Redactor) redactWorkload(o *unstructured.Unstructured, key string, fields ...string) {
+	ww, ok, _ := unstructured.NestedSlice(o.Object, fields...)
+	if !ok {
+		return
+	}
+	var redacted bool
+	for i, w := range ww {
+		if key == "" {
+			ww[i], ok = r.redactManifest(w)
+			redacted = redacted || ok
+			continue
+		}
+		m, isMap := w.(map[string]interface{})
+		if !isMap {
+			continue
+		}
+		if m[key], ok = r.redactManifest(m[key]); ok {
+			redacted = true
+		}
+	}
+	if !redacted {
+		return
+	}
+	_ = unstructured.SetNestedSlice(o.Object, ww, fields...)
+	dropLastApplied(o)
+}
+
+// redactManifest scrubs an embedded Secret manifest given either in object or raw form.
+func (r *Redactor) redactManifest(m interface{}) (interface{}, bool) {
+	switch raw := m.(type) {
+	case map[string]interface{}:
+		return raw, r.redactEmbeddedSecret(raw)
+	case string:
+		var mo map[string]interface{}
+		if err := yaml.Unmarshal([]byte(raw), &mo); err != nil || !r.redactEmbeddedSecret(mo) {
+			return m, false
+		}
+		bb, err := json.Marshal(mo)
+		if err != nil {
+			return m, false
+		}
+		return string(bb), true
+	default:
+		return m, false
+	}
+}
+
+func (r *Redactor) redactEmbeddedSecret(mo map[string]interface{}) bool {
+	u := unstructured.Unstructured{Object: mo}
+	if gvk := u.GroupVersionKind(); gvk.Group != "" || gvk.Kind != "Secret" {
+		return false
+	}
+	r.redactSecret(&u)
+
+	return true
+}
+
+func (r *Redactor) redactSecret(o *unstructured.Unstructured) {
+	redacted := base64.StdEncoding.EncodeToString([]byte(RedactedValue))
+	if data, ok, _ := unstructured.NestedMap(o.Object, "data"); ok {
+		for k := range data {
+			data[k] = redacted
+		}
+		_ = unstructured.SetNestedMap(o.Object, data, "data")
+	}
+	if data, ok, _ := unstructured.NestedMap(o.Object, "stringData"); ok {
+		for k := range data {
+			data[k] = RedactedValue
+		}
+		_ = unstructured.SetNestedMap(o.Object, data, "stringData")
+	}
+	dropLastApplied(o)
+}
+
+func (r *Redactor) redactCluster(o *unstructured.Unstructured) {
+	var redacted bool
+	if pp, ok, _ := unstructured.NestedMap(o.Object, "spec", "properties"); ok {
+		if r.redactProperties(pp) {
+			_ = unstructured.SetNestedMap(o.Object, pp, "spec", "properties")
+			redacted = true
+		}
+	}
+	if ss, ok, _ := unstructured.NestedSlice(o.Object, "status", "provisioningStatus"); ok {
+		for _, s := range ss {
+			step, ok := s.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			if pp, ok := step["properties"].(map[string]interface{}); ok && r.redactProperties(pp) {
+				redacted = true
+			}
+		}
+		_ = unstructured.SetNestedSlice(o.Object, ss, "status", "provisioningStatus")
+	}
+	if redacted {
+		dropLastApplied(o)
+	}
+}
+
+// redactProperties scrubs property values whose key matches a redaction pattern.
+func (r *Redactor) redactProperties(pp map[string]interface{}) bool {
+	var redacted bool
+	for k := range pp {
+		for _, rx := range r.properties {
+			if rx.MatchString(k) {
+				pp[k], redacted = RedactedValue, true
+				break
+			}
+		}
+	}
+
+	return redacted
+}
+
+// ExportFleetSnapshot writes all fleet resources and the core resources of the configured
+// namespaces to a timestamped tarball in a given directory and returns its location.
+func ExportFleetSnapshot(ctx context.Context, c client.Connection, dir string, cfg *config.FleetSnapshot) (string, error) {
+	r, err := NewRedactor(cfg)
+	if err != nil {
+		return "", err
+	}
+	ee, err := collectFleetSnapshot(ctx, c, cfg.Namespaces)
+	if err != nil {
+		return "", err
+	}
+	for _, e := range ee {
+		for i := range e.oo {
+			unstructured.RemoveNestedField(e.oo[i].Object, "metadata", "managedFields")
+			r.Redact(&e.oo[i])
+		}
+	}
+
+	if err := config.EnsureFullPath(dir, config.DefaultDirMod); err != nil {
+		return "", err
+	}
+	fqn := filepath.Join(dir, fmt.Sprintf("fleet-snapshot-%d.tar.gz", time.Now().UnixNano()))
+
+	return fqn, writeSnapshot(fqn, ee)
+}
+
+func collectFleetSnapshot(ctx context.Context, c client.Connection, nss []string) ([]snapshotEntry, error) {
+	dial, err := c.DynDial()
+	if err != nil {
+		return nil, err
+	}
+	disc, err := c.CachedDiscovery()
+	if err != nil {
+		return nil, err
+	}
+	ll, err := disc.ServerPreferredResources()
+	if err != nil {
+		log.Warn().Err(err).Msg("Snapshot discovery incomplete")
+	}
+
+	var ee []snapshotEntry
+	crds, err := dial.Resource(crdGVR).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		log.Warn().Err(err).Msg("Snapshot unable to list fleet CRDs")
+	} else {
+		e := snapshotEntry{name: path.Join("fleet", "crds.yaml")}
+		for _, o := range crds.Items {
+			if g, _, _ := unstructured.NestedString(o.Object, "spec", "group"); g == FleetGroup {
+				e.oo = append(e.oo, o)
+			}
+		}
+		ee = append(ee, e)
+	}
+
+	for _, l := range ll {
+		gv, err := schema.ParseGroupVersion(l.GroupVersion)
+		if err != nil || gv.Group != FleetGroup {
+			continue
+		}
+		for _, res := range listableResources(l.APIResources, false) {
+			oo, err := dial.Resource(gv.WithResource(res.Name)).Namespace(client.AllNamespaces).List(ctx, metav1.ListOptions{})
+			if err != nil {
+				return nil, fmt.Errorf("unable to list %s: %w", res.Name, err)
+			}
+			ee = append(ee, snapshotEntry{name: path.Join("fleet", res.Name+".yaml"), oo: oo.Items})
+		}
+	}
+	if len(nss) == 0 {
+		return ee, nil
+	}
+
+	core, err := disc.ServerResourcesForGroupVersion("v1")
+	if err != nil {
+		return nil, err
+	}
+	nsGVR := schema.GroupVersionResource{Version: "v1", Resource: "namespaces"}
+	for _, ns := range nss {
+		o, err := dial.Resource(nsGVR).Get(ctx, ns, metav1.GetOptions{})
+		if err != nil {
+			return nil, err
+		}
+		ee = append(ee, snapshotEntry{name: path.Join("core", ns, "namespace.yaml"), oo: []unstructured.Unstructured{*o}})
+		for _, res := range listableResources(core.APIResources, true) {
+			oo, err := dial.Resource(nsGVR.GroupVersion().WithResource(res.Name)).Namespace(ns).List(ctx, metav1.ListOptions{})
+			if err != nil {
+				log.Warn().Err(err).Msgf("Snapshot skipping %s in %q", res.Name, ns)
+				continue
+			}
+			ee = append(ee, snapshotEntry{name: path.Join("core", ns, res.Name+".yaml"), oo: oo.Items})
+		}
+	}
+
+	return ee, nil
+}
+
+// listableResources returns all listable resources, skipping subresources.
+func listableResources(rr []metav1.APIResource, namespacedOnly bool) []metav1.APIResource {
+	ll := make([]metav1.APIResource, 0, len(rr))
+	for _, r := range rr {
+		if strings.Contains(r.Name, "/") || (namespacedOnly && !r.Namespaced) {
+			continue
+		}
+		for _, v := range r.Verbs {
+			if v == client.ListVerb {
+				ll = append(ll, r)
+				break
+			}
+		}
+	}
+	sort.Slice(ll, func(i, j int) bool {
+		return ll[i].Name < ll[j].Name
+	})
+
+	return ll
+}
+
+func writeSnapshot(fqn string, ee []snapshotEntry) error {
+	f, err := os.OpenFile(fqn, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0600)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := f.Close(); err != nil {
+			log.Error().Err(err).Msg("Closing snapshot file")
+		}
+	}()
+	gz := gzip.NewWriter(f)
+	tw := tar.NewWriter(gz)
+	now := time.Now()
+	for _, e := range ee {
+		if len(e.oo) == 0 {
+			continue
+		}
+		raw, err := toMultiDocYAML(e.oo)
+		if err != nil {
+			return err
+		}
+		hdr := tar.Header{
+			Name:    e.name,
+			Mode:    0600,
+			Size:    int64(len(raw)),
+			ModTime: now,
+		}
+		if err := tw.WriteHeader(&hdr); err != nil {
+			return err
+		}
+		if _, err := tw.Write(raw); err != nil {
+			return err
+		}
+	}
+	if err := tw.Close(); err != nil {
+		return err
+	}
+
+	return gz.Close()
+}
+
+func toMultiDocYAML(oo []unstructured.Unstructured) ([]byte, error) {
+	var buff bytes.Buffer
+	for i := range oo {
+		raw, err := yaml.Marshal(oo[i].Object)
+		if err != nil {
+			return nil, err
+		}
+		if i > 0 {
+			buff.WriteString("---\n")
+		}
+		buff.Write(raw)
+	}
+
+	return buff.Bytes(), nil
+}
+
+func dropLastApplied(o *unstructured.Unstructured) {
+	aa := o.GetAnnotations()
+	if _, ok := aa[lastAppliedAnnotation]; !ok {
+		return
+	}
+	delete(aa, lastAppliedAnnotation)
+	o.SetAnnotations(aa)
+}
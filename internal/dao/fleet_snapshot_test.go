@@ -0,0 +1,145 @@
+package dao
+
+import (
+	"encoding/base64"
+	"testing"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestRedactorSecret(t *testing.T) {
+	uu := map[string]struct {
+		keep bool
+		e    string
+	}{
+		"redact": {e: base64.StdEncoding.EncodeToString([]byte(RedactedValue))},
+		"keep":   {keep: true, e: "c2VjcmV0"},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			o := unstructured.Unstructured{Object: map[string]interface{}{
+				"apiVersion": "v1",
+				"kind":       "Secret",
+				"metadata": map[string]interface{}{
+					"name":        "s1",
+					"annotations": map[string]interface{}{lastAppliedAnnotation: "{}"},
+				},
+				"data": map[string]interface{}{"password": "c2VjcmV0"},
+			}}
+			r, err := NewRedactor(&config.FleetSnapshot{KeepSecretData: u.keep})
+			assert.Nil(t, err)
+			r.Redact(&o)
+
+			v, _, _ := unstructured.NestedString(o.Object, "data", "password")
+			assert.Equal(t, u.e, v)
+			_, ok := o.GetAnnotations()[lastAppliedAnnotation]
+			assert.Equal(t, u.keep, ok)
+		})
+	}
+}
+
+func TestRedactorClusterProperties(t *testing.T) {
+	o := unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": FleetGroup + "/v1alpha1",
+		"kind":       "Cluster",
+		"metadata":   map[string]interface{}{"name": "c1"},
+		"spec": map[string]interface{}{
+			"properties": map[string]interface{}{"region": "eastus", "adminPassword": "fred", "sshKey": "blee"},
+		},
+		"status": map[string]interface{}{
+			"provisioningStatus": []interface{}{
+				map[string]interface{}{"properties": map[string]interface{}{"apiToken": "zorg", "sshKey": "duh"}},
+			},
+		},
+	}}
+	r, err := NewRedactor(&config.FleetSnapshot{RedactProperties: []string{"(?i)password", "(?i)token", "^sshKey$"}})
+	assert.Nil(t, err)
+	r.Redact(&o)
+
+	pp, _, _ := unstructured.NestedStringMap(o.Object, "spec", "properties")
+	assert.Equal(t, map[string]string{"region": "eastus", "adminPassword": RedactedValue, "sshKey": RedactedValue}, pp)
+	ss, _, _ := unstructured.NestedSlice(o.Object, "status", "provisioningStatus")
+	assert.Equal(t, map[string]interface{}{"apiToken": RedactedValue, "sshKey": RedactedValue}, ss[0].(map[string]interface{})["properties"])
+}
+
+func TestRedactorDefaults(t *testing.T) {
+	r, err := NewRedactor(config.NewFleetSnapshot())
+	assert.Nil(t, err)
+	assert.True(t, r.redactProperties(map[string]interface{}{"clientSecret": "x"}))
+	assert.False(t, r.redactProperties(map[string]interface{}{"region": "x"}))
+
+	_, err = NewRedactor(&config.FleetSnapshot{RedactProperties: []string{"("}})
+	assert.ErrorContains(t, err, "invalid redaction pattern")
+}
+
+func TestRedactorApplicationSecretWorkload(t *testing.T) {
+	secret := func() map[string]interface{} {
+		return map[string]interface{}{
+			"apiVersion": "v1",
+			"kind":       "Secret",
+			"metadata":   map[string]interface{}{"name": "db"},
+			"data":       map[string]interface{}{"password": "c2VjcmV0"},
+			"stringData": map[string]interface{}{"token": "blee"},
+		}
+	}
+	o := unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": FleetGroup + "/v1alpha1",
+		"kind":       "Application",
+		"metadata": map[string]interface{}{
+			"name":        "a1",
+			"annotations": map[string]interface{}{lastAppliedAnnotation: "{}"},
+		},
+		"spec": map[string]interface{}{
+			"workload": []interface{}{
+				map[string]interface{}{"manifest": secret()},
+				map[string]interface{}{"manifest": `{"apiVersion":"v1","kind":"Secret","metadata":{"name":"raw"},"data":{"key":"c2VjcmV0"}}`},
+				map[string]interface{}{"manifest": map[string]interface{}{"apiVersion": "v1", "kind": "ConfigMap", "data": map[string]interface{}{"k": "v"}}},
+			},
+		},
+	}}
+	r, err := NewRedactor(config.NewFleetSnapshot())
+	assert.Nil(t, err)
+	r.Redact(&o)
+
+	redacted := base64.StdEncoding.EncodeToString([]byte(RedactedValue))
+	ww, _, _ := unstructured.NestedSlice(o.Object, "spec", "workload")
+	m := ww[0].(map[string]interface{})["manifest"].(map[string]interface{})
+	assert.Equal(t, map[string]interface{}{"password": redacted}, m["data"])
+	assert.Equal(t, map[string]interface{}{"token": RedactedValue}, m["stringData"])
+	assert.Equal(t, `{"apiVersion":"v1","data":{"key":"`+redacted+`"},"kind":"Secret","metadata":{"name":"raw"}}`, ww[1].(map[string]interface{})["manifest"])
+	assert.Equal(t, map[string]interface{}{"k": "v"}, ww[2].(map[string]interface{})["manifest"].(map[string]interface{})["data"])
+	_, ok := o.GetAnnotations()[lastAppliedAnnotation]
+	assert.False(t, ok)
+
+	keep, err := NewRedactor(&config.FleetSnapshot{KeepSecretData: true})
+	assert.Nil(t, err)
+	o.Object["spec"] = map[string]interface{}{"workload": []interface{}{map[string]interface{}{"manifest": secret()}}}
+	keep.Redact(&o)
+	ww, _, _ = unstructured.NestedSlice(o.Object, "spec", "workload")
+	assert.Equal(t, map[string]interface{}{"password": "c2VjcmV0"}, ww[0].(map[string]interface{})["manifest"].(map[string]interface{})["data"])
+}
+
+func TestRedactorManifestWorkSecret(t *testing.T) {
+	o := unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": FleetGroup + "/v1alpha1",
+		"kind":       "ManifestWork",
+		"metadata":   map[string]interface{}{"name": "mw1"},
+		"spec": map[string]interface{}{
+			"workload": map[string]interface{}{
+				"manifests": []interface{}{
+					map[string]interface{}{"apiVersion": "v1", "kind": "Secret", "data": map[string]interface{}{"password": "c2VjcmV0"}},
+				},
+			},
+		},
+	}}
+	r, err := NewRedactor(config.NewFleetSnapshot())
+	assert.Nil(t, err)
+	r.Redact(&o)
+
+	mm, _, _ := unstructured.NestedSlice(o.Object, "spec", "workload", "manifests")
+	assert.Equal(t, map[string]interface{}{"password": base64.StdEncoding.EncodeToString([]byte(RedactedValue))}, mm[0].(map[string]interface{})["data"])
+}
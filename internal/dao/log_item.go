@@ -2,6 +2,7 @@ package dao
 
 import (
 	"bytes"
+	"hash/fnv"
 )
 
 // LogChan represents a channel for logs.
@@ -11,6 +12,7 @@ var ItemEOF = new(LogItem)
 
 // LogItem represents a container log line.
 type LogItem struct {
+	Cluster         string
 	Pod, Container  string
 	SingleContainer bool
 	Bytes           []byte
@@ -60,7 +62,7 @@ func (l *LogItem) IsEmpty() bool {
 
 // Size returns the size of the item.
 func (l *LogItem) Size() int {
-	return 100 + len(l.Bytes) + len(l.Pod) + len(l.Container)
+	return 100 + len(l.Bytes) + len(l.Cluster) + len(l.Pod) + len(l.Container)
 }
 
 // Render returns a log line as string.
@@ -76,6 +78,10 @@ func (l *LogItem) Render(paint string, showTime bool, bb *bytes.Buffer) {
 		bb.WriteString("[-::]")
 	}
 
+	if l.Cluster != "" {
+		bb.WriteString("[" + clusterColor(l.Cluster) + "::b]" + l.Cluster + "[-::-] ")
+	}
+
 	if l.Pod != "" {
 		bb.WriteString("[" + paint + "::]" + l.Pod)
 	}
@@ -95,3 +101,22 @@ func (l *LogItem) Render(paint string, showTime bool, bb *bytes.Buffer) {
 		bb.Write(l.Bytes)
 	}
 }
+
+var clusterPalette = []string{
+	"orange",
+	"dodgerblue",
+	"hotpink",
+	"gold",
+	"springgreen",
+	"violet",
+	"coral",
+	"skyblue",
+}
+
+// clusterColor returns a stable color for a given cluster.
+func clusterColor(cluster string) string {
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(cluster))
+
+	return clusterPalette[h.Sum32()%uint32(len(clusterPalette))]
+}
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"fmt"
 	"regexp"
+	"sort"
 	"strings"
 	"sync"
 
@@ -25,6 +26,7 @@ var podPalette = []string{
 type LogItems struct {
 	items     []*LogItem
 	podColors map[string]string
+	muted     map[string]struct{}
 	mx        sync.RWMutex
 }
 
@@ -32,9 +34,61 @@ type LogItems struct {
 func NewLogItems() *LogItems {
 	return &LogItems{
 		podColors: make(map[string]string),
+		muted:     make(map[string]struct{}),
 	}
 }
 
+// ToggleMute mutes or unmutes a given cluster lines. Returns true if the cluster is now muted.
+func (l *LogItems) ToggleMute(cluster string) bool {
+	l.mx.Lock()
+	defer l.mx.Unlock()
+
+	if _, ok := l.muted[cluster]; ok {
+		delete(l.muted, cluster)
+		return false
+	}
+	l.muted[cluster] = struct{}{}
+
+	return true
+}
+
+// IsMuted checks if a given cluster lines are muted.
+func (l *LogItems) IsMuted(cluster string) bool {
+	l.mx.RLock()
+	defer l.mx.RUnlock()
+
+	_, ok := l.muted[cluster]
+	return ok
+}
+
+// Clusters returns the sorted names of the clusters the items came from.
+func (l *LogItems) Clusters() []string {
+	l.mx.RLock()
+	defer l.mx.RUnlock()
+
+	set := make(map[string]struct{})
+	for _, item := range l.items {
+		if item.Cluster != "" {
+			set[item.Cluster] = struct{}{}
+		}
+	}
+	cc := make([]string, 0, len(set))
+	for c := range set {
+		cc = append(cc, c)
+	}
+	sort.Strings(cc)
+
+	return cc
+}
+
+func (l *LogItems) isMuted(item *LogItem) bool {
+	if item.Cluster == "" {
+		return false
+	}
+	_, ok := l.muted[item.Cluster]
+	return ok
+}
+
 // Items returns the log items.
 func (l *LogItems) Items() []*LogItem {
 	l.mx.RLock()
@@ -75,9 +129,15 @@ func (l *LogItems) Subset(index int) *LogItems {
 	l.mx.RLock()
 	defer l.mx.RUnlock()
 
+	muted := make(map[string]struct{}, len(l.muted))
+	for c := range l.muted {
+		muted[c] = struct{}{}
+	}
+
 	return &LogItems{
 		items:     l.items[index:],
 		podColors: l.podColors,
+		muted:     muted,
 	}
 }
 
@@ -107,6 +167,9 @@ func (l *LogItems) Lines(index int, showTime bool, ll [][]byte) {
 
 	var colorIndex int
 	for i, item := range l.items[index:] {
+		if l.isMuted(item) {
+			continue
+		}
 		id := item.ID()
 		color, ok := l.podColors[id]
 		if !ok {
@@ -130,6 +193,9 @@ func (l *LogItems) StrLines(index int, showTime bool) []string {
 
 	ll := make([]string, len(l.items[index:]))
 	for i, item := range l.items[index:] {
+		if l.isMuted(item) {
+			continue
+		}
 		bb := bytes.NewBuffer(make([]byte, 0, item.Size()))
 		item.Render("white", showTime, bb)
 		ll[i] = bb.String()
@@ -140,8 +206,14 @@ func (l *LogItems) StrLines(index int, showTime bool) []string {
 
 // Render returns logs as a collection of strings.
 func (l *LogItems) Render(index int, showTime bool, ll [][]byte) {
+	l.mx.Lock()
+	defer l.mx.Unlock()
+
 	var colorIndex int
 	for i, item := range l.items[index:] {
+		if l.isMuted(item) {
+			continue
+		}
 		id := item.ID()
 		color, ok := l.podColors[id]
 		if !ok {
@@ -209,6 +281,10 @@ func (l *LogItems) filterLogs(index int, q string, showTime bool) ([]int, [][]in
 	ll := make([][]byte, len(l.items[index:]))
 	l.Lines(index, showTime, ll)
 	for i, line := range ll {
+		// Skips muted lines.
+		if len(line) == 0 {
+			continue
+		}
 		locs := rx.FindIndex(line)
 		if locs != nil && invert {
 			continue
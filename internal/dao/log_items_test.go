@@ -126,3 +126,53 @@ func TestLogItemsRender(t *testing.T) {
 		})
 	}
 }
+
+func TestLogItemsMute(t *testing.T) {
+	ii := dao.NewLogItems()
+	for _, c := range []string{"c2", "c1", "c2"} {
+		item := dao.NewLogItemFromString("2018-12-14T10:36:43.326972-07:00 Testing " + c + "\n")
+		item.Cluster, item.Pod, item.Container = c, "fred", "blee"
+		ii.Add(item)
+	}
+	assert.Equal(t, []string{"c1", "c2"}, ii.Clusters())
+
+	assert.True(t, ii.ToggleMute("c2"))
+	assert.True(t, ii.IsMuted("c2"))
+	res := make([][]byte, ii.Len())
+	ii.Render(0, false, res)
+	assert.Empty(t, res[0])
+	assert.Contains(t, string(res[1]), "c1[-::-] [teal::]fred")
+	assert.Empty(t, res[2])
+
+	mm, _, err := ii.Filter(0, "!zorg", false)
+	assert.NoError(t, err)
+	assert.Equal(t, []int{1}, mm)
+
+	assert.False(t, ii.ToggleMute("c2"))
+	ii.Render(0, false, res)
+	assert.NotEmpty(t, res[0])
+}
+
+func TestLogItemsMuteConcurrent(t *testing.T) {
+	ii := dao.NewLogItems()
+	for _, c := range []string{"c1", "c2"} {
+		item := dao.NewLogItemFromString("2018-12-14T10:36:43.326972-07:00 Testing " + c + "\n")
+		item.Cluster, item.Pod, item.Container = c, "fred", "blee"
+		ii.Add(item)
+	}
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for i := 0; i < 100; i++ {
+			ii.ToggleMute("c1")
+		}
+	}()
+	res := make([][]byte, ii.Len())
+	for i := 0; i < 100; i++ {
+		ii.Render(0, false, res)
+		ii.Subset(0).Render(0, false, res)
+	}
+	<-done
+	assert.False(t, ii.IsMuted("c1"))
+}
@@ -0,0 +1,64 @@
+package dao
+
+import (
+	"context"
+	"sort"
+	"time"
+)
+
+// MergeLogs merges log items coming from several streams in timestamp order.
+// Items are held back for the given window so late lines from slower streams can be ordered.
+func MergeLogs(ctx context.Context, in LogChan, window time.Duration) LogChan {
+	out := make(LogChan, 2)
+	go func() {
+		defer close(out)
+		ticker := time.NewTicker(window)
+		defer ticker.Stop()
+
+		var buff []*LogItem
+		flush := func() bool {
+			sortLogItems(buff)
+			for _, item := range buff {
+				select {
+				case <-ctx.Done():
+					return false
+				case out <- item:
+				}
+			}
+			buff = buff[:0]
+			return true
+		}
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case item, ok := <-in:
+				if !ok {
+					flush()
+					return
+				}
+				buff = append(buff, item)
+			case <-ticker.C:
+				if !flush() {
+					return
+				}
+			}
+		}
+	}()
+
+	return out
+}
+
+// sortLogItems orders log items by timestamp. Items without a valid timestamp come first.
+func sortLogItems(ii []*LogItem) {
+	tt := make(map[*LogItem]time.Time, len(ii))
+	for _, item := range ii {
+		t, err := time.Parse(time.RFC3339Nano, item.GetTimestamp())
+		if err == nil {
+			tt[item] = t
+		}
+	}
+	sort.SliceStable(ii, func(i, j int) bool {
+		return tt[ii[i]].Before(tt[ii[j]])
+	})
+}
@@ -0,0 +1,38 @@
+package dao_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMergeLogs(t *testing.T) {
+	in := make(dao.LogChan, 10)
+	for _, l := range []string{
+		"2022-01-01T10:00:02.5Z c2 two",
+		"2022-01-01T10:00:01Z c1 one",
+		"2022-01-01T10:00:02.25Z c1 three",
+		"2022-01-01T10:00:00.123456789Z c3 zero",
+	} {
+		in <- dao.NewLogItemFromString(l)
+	}
+	close(in)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	out := dao.MergeLogs(ctx, in, 50*time.Millisecond)
+
+	ss := make([]string, 0, 4)
+	for item := range out {
+		ss = append(ss, string(item.Bytes))
+	}
+	assert.Equal(t, []string{
+		"2022-01-01T10:00:00.123456789Z c3 zero",
+		"2022-01-01T10:00:01Z c1 one",
+		"2022-01-01T10:00:02.25Z c1 three",
+		"2022-01-01T10:00:02.5Z c2 two",
+	}, ss)
+}
@@ -7,7 +7,6 @@ import (
 	"github.com/derailed/k9s/internal"
 	"github.com/derailed/k9s/internal/render"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
-	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -29,7 +28,7 @@ func (c *Manifest) List(ctx context.Context, _ string) ([]runtime.Object, error)
 	}
 
 	//fqn = "clusterfleet/" + fqn
-	app, err := c.fetchApplication(fqn)
+	app, err := FetchApplication(c.GetFactory(), fqn)
 	if err != nil {
 		return nil, err
 	}
@@ -39,26 +38,25 @@ func (c *Manifest) List(ctx context.Context, _ string) ([]runtime.Object, error)
 		statuses[fmt.Sprintf("%s.%s.%s", status.Namespace, status.Name, status.Kind)] = status
 	}
 
-	for _, co := range app.Spec.Workload {
-		res = append(res, c.makeManifestResp(co.ManifestItem, statuses))
+	mm := render.FleetObjects.Manifests(app)
+	for i, co := range app.Spec.Workload {
+		if mm[i].Err != nil {
+			manifest := co.ManifestItem
+			res = append(res, render.ManifestRes{
+				Manifest: &manifest,
+				Name:     fmt.Sprintf("manifest-%d", i),
+				Replicas: "-",
+				Err:      mm[i].Err,
+			})
+			continue
+		}
+		res = append(res, c.makeManifestResp(co.ManifestItem, mm[i].Object, statuses))
 	}
 
 	return res, nil
 }
 
-func (c *Manifest) fetchApplication(fqn string) (*render.Application, error) {
-	o, err := c.GetFactory().Get("apis.clusterfleet.io/v1alpha1/applications", fqn, true, labels.Everything())
-	if err != nil {
-		return nil, err
-	}
-	var app render.Application
-	err = runtime.DefaultUnstructuredConverter.FromUnstructured(o.(*unstructured.Unstructured).Object, &app)
-	return &app, err
-}
-
-func (c *Manifest) makeManifestResp(manifest render.Manifest, statuses map[string]render.ManifestStatus) render.ManifestRes {
-
-	mo, _ := render.ManifestToUnstructed(manifest)
+func (c *Manifest) makeManifestResp(manifest render.Manifest, mo *unstructured.Unstructured, statuses map[string]render.ManifestStatus) render.ManifestRes {
 
 	name := render.GetNameFromUnstructured(mo)
 	namespace := render.GetNamespaceFromUnstructured(mo)
@@ -73,5 +71,6 @@ func (c *Manifest) makeManifestResp(manifest render.Manifest, statuses map[strin
 		Namespace: namespace,
 		Kind:      kind,
 		Replicas:  replicas,
+		Object:    mo,
 	}
 }
@@ -0,0 +1,117 @@
+package dao
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/yaml"
+)
+
+// ManifestYAML returns an application workload manifest as YAML given its namespace/name_kind id.
+func (c *Manifest) ManifestYAML(app, id string) (string, error) {
+	a, err := FetchApplication(c.GetFactory(), app)
+	if err != nil {
+		return "", err
+	}
+	mo, err := applicationManifest(a, id)
+	if err != nil {
+		return "", err
+	}
+
+	return ToYAML(mo, false)
+}
+
+// ValidateEdit checks an edited application workload manifest and returns its normalized YAML.
+func (c *Manifest) ValidateEdit(app, id string, raw []byte) (string, error) {
+	a, err := FetchApplication(c.GetFactory(), app)
+	if err != nil {
+		return "", err
+	}
+	mo, err := applicationManifest(a, id)
+	if err != nil {
+		return "", err
+	}
+	edited, err := ParseManifestEdit(mo, raw)
+	if err != nil {
+		return "", err
+	}
+
+	return ToYAML(edited, false)
+}
+
+// UpdateManifest replaces an application workload manifest with an edited version.
+// The edited manifest must retain the kind, name and namespace of the original.
+func (c *Manifest) UpdateManifest(app, id string, raw []byte) error {
+	a, err := FetchApplication(c.GetFactory(), app)
+	if err != nil {
+		return err
+	}
+	i, mo, err := manifestIndex(a, id)
+	if err != nil {
+		return err
+	}
+	edited, err := ParseManifestEdit(mo, raw)
+	if err != nil {
+		return err
+	}
+	patch, err := manifestPatch(i, mo, edited)
+	if err != nil {
+		return err
+	}
+
+	dial, err := c.GetFactory().Client().DynDial()
+	if err != nil {
+		return err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), c.GetFactory().Client().Config().CallTimeout())
+	defer cancel()
+	ns, n := client.Namespaced(app)
+	_, err = dial.Resource(client.NewGVR(applicationGVR).GVR()).Namespace(ns).Patch(
+		ctx,
+		n,
+		types.JSONPatchType,
+		patch,
+		metav1.PatchOptions{},
+	)
+
+	return err
+}
+
+// ParseManifestEdit parses an edited manifest and checks it retains the original manifest identity.
+func ParseManifestEdit(original *unstructured.Unstructured, raw []byte) (*unstructured.Unstructured, error) {
+	var m map[string]interface{}
+	if err := yaml.Unmarshal(raw, &m); err != nil {
+		return nil, fmt.Errorf("invalid manifest yaml: %w", err)
+	}
+	if len(m) == 0 {
+		return nil, fmt.Errorf("edited manifest is empty")
+	}
+	edited := unstructured.Unstructured{Object: m}
+	if edited.GetAPIVersion() == "" || edited.GetKind() == "" {
+		return nil, fmt.Errorf("edited manifest must specify an apiVersion and kind")
+	}
+	if manifestID(&edited) != manifestID(original) {
+		return nil, fmt.Errorf("manifest identity changed from %q to %q", manifestID(original), manifestID(&edited))
+	}
+
+	return &edited, nil
+}
+
+// manifestPatch returns a JSON patch replacing the manifest at a given workload index.
+// The patch first tests the manifest identity so a concurrently reordered workload is not clobbered.
+func manifestPatch(i int, original, edited *unstructured.Unstructured) ([]byte, error) {
+	path := fmt.Sprintf("/spec/workload/%d/manifest", i)
+	ops := []map[string]interface{}{
+		{"op": "test", "path": path + "/kind", "value": render.GetKindFromUnstructured(original)},
+		{"op": "test", "path": path + "/metadata/name", "value": render.GetNameFromUnstructured(original)},
+		{"op": "replace", "path": path, "value": edited.Object},
+	}
+
+	return json.Marshal(ops)
+}
@@ -0,0 +1,76 @@
+package dao
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestParseManifestEdit(t *testing.T) {
+	original := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "apps/v1",
+		"kind":       "Deployment",
+		"metadata":   map[string]interface{}{"name": "nginx", "namespace": "default"},
+	}}
+
+	uu := map[string]struct {
+		raw string
+		err string
+	}{
+		"ok": {
+			raw: "apiVersion: apps/v1\nkind: Deployment\nmetadata:\n  name: nginx\n  namespace: default\nspec:\n  replicas: 2\n",
+		},
+		"bad-yaml": {
+			raw: "apiVersion: [apps/v1\n",
+			err: "invalid manifest yaml",
+		},
+		"empty": {
+			raw: "",
+			err: "edited manifest is empty",
+		},
+		"no-kind": {
+			raw: "apiVersion: apps/v1\nmetadata:\n  name: nginx\n",
+			err: "edited manifest must specify an apiVersion and kind",
+		},
+		"renamed": {
+			raw: "apiVersion: apps/v1\nkind: Deployment\nmetadata:\n  name: fred\n  namespace: default\n",
+			err: `manifest identity changed from "default/nginx_Deployment" to "default/fred_Deployment"`,
+		},
+		"moved": {
+			raw: "apiVersion: apps/v1\nkind: Deployment\nmetadata:\n  name: nginx\n  namespace: blee\n",
+			err: `manifest identity changed from "default/nginx_Deployment" to "blee/nginx_Deployment"`,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			edited, err := ParseManifestEdit(original, []byte(u.raw))
+			if u.err != "" {
+				assert.ErrorContains(t, err, u.err)
+				return
+			}
+			assert.Nil(t, err)
+			assert.Equal(t, "nginx", edited.GetName())
+		})
+	}
+}
+
+func TestManifestPatch(t *testing.T) {
+	original := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "ConfigMap",
+		"metadata":   map[string]interface{}{"name": "cm"},
+	}}
+	edited := original.DeepCopy()
+	edited.Object["data"] = map[string]interface{}{"a": "b"}
+
+	patch, err := manifestPatch(2, original, edited)
+	assert.Nil(t, err)
+	assert.JSONEq(t, `[
+		{"op":"test","path":"/spec/workload/2/manifest/kind","value":"ConfigMap"},
+		{"op":"test","path":"/spec/workload/2/manifest/metadata/name","value":"cm"},
+		{"op":"replace","path":"/spec/workload/2/manifest","value":{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"cm"},"data":{"a":"b"}}}
+	]`, string(patch))
+}
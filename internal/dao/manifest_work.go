@@ -0,0 +1,130 @@
+package dao
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const manifestWorkGVR = "apis.clusterfleet.io/v1alpha1/manifestworks"
+
+var _ Accessor = (*WorkManifest)(nil)
+
+// WorkManifest represents the manifests of a fleet ManifestWork.
+type WorkManifest struct {
+	NonResource
+}
+
+// List returns a collection of manifests along with their status for a ManifestWork.
+func (w *WorkManifest) List(ctx context.Context, _ string) ([]runtime.Object, error) {
+	fqn, ok := ctx.Value(internal.KeyPath).(string)
+	if !ok || fqn == "" {
+		return nil, fmt.Errorf("no context path for %q", w.gvr)
+	}
+
+	mw, err := FetchManifestWork(w.GetFactory(), fqn)
+	if err != nil {
+		return nil, err
+	}
+
+	return workManifests(mw), nil
+}
+
+// FetchManifestWork retrieves a ManifestWork given its fully qualified name.
+func FetchManifestWork(f Factory, fqn string) (*render.ManifestWork, error) {
+	ns, _ := client.Namespaced(fqn)
+	feedFleetCache(f, ns, manifestWorkGVR)
+	o, err := f.Get(manifestWorkGVR, fqn, true, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	u, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return nil, fmt.Errorf("expecting unstructured but got %T", o)
+	}
+
+	return render.FleetObjects.ManifestWork(u)
+}
+
+// FetchManifestWorks retrieves all ManifestWorks in a given namespace.
+func FetchManifestWorks(f Factory, ns string) ([]render.ManifestWork, error) {
+	feedFleetCache(f, ns, manifestWorkGVR)
+	oo, err := f.List(manifestWorkGVR, ns, true, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	ww := make([]render.ManifestWork, 0, len(oo))
+	for _, o := range oo {
+		u, ok := o.(*unstructured.Unstructured)
+		if !ok {
+			return nil, fmt.Errorf("expecting unstructured but got %T", o)
+		}
+		mw, err := render.FleetObjects.ManifestWork(u)
+		if err != nil {
+			return nil, err
+		}
+		ww = append(ww, *mw)
+	}
+
+	return ww, nil
+}
+
+func workManifests(mw *render.ManifestWork) []runtime.Object {
+	statuses := make(map[string]render.ManifestStatus, len(mw.Status.ManifestStatuses))
+	for _, ms := range mw.Status.ManifestStatuses {
+		statuses[manifestKey(ms.Namespace, ms.Name, ms.Kind)] = ms
+	}
+
+	res := make([]runtime.Object, 0, len(mw.Spec.Workload.Manifests))
+	for i, m := range render.FleetObjects.WorkManifests(mw) {
+		if m.Err != nil {
+			res = append(res, render.ClusterManifestRes{
+				Cluster:  mw.Namespace,
+				Name:     fmt.Sprintf("manifest-%d", i),
+				Replicas: "-",
+				Err:      m.Err,
+			})
+			continue
+		}
+		mo := m.Object
+		cm := render.ClusterManifestRes{
+			Cluster:   mw.Namespace,
+			Name:      render.GetNameFromUnstructured(mo),
+			Namespace: render.GetNamespaceFromUnstructured(mo),
+			Kind:      render.GetKindFromUnstructured(mo),
+			Replicas:  render.GetReplicaForUnstructured(mo),
+			Object:    mo,
+		}
+		key := manifestKey(cm.Namespace, cm.Name, cm.Kind)
+		if ms, ok := statuses[key]; ok {
+			cm.Status = &ms
+			delete(statuses, key)
+		}
+		res = append(res, cm)
+	}
+	// Surface reported statuses for manifests no longer part of the workload.
+	for _, ms := range mw.Status.ManifestStatuses {
+		key := manifestKey(ms.Namespace, ms.Name, ms.Kind)
+		st, ok := statuses[key]
+		if !ok {
+			continue
+		}
+		delete(statuses, key)
+		res = append(res, render.ClusterManifestRes{
+			Cluster:   mw.Namespace,
+			Name:      st.Name,
+			Namespace: st.Namespace,
+			Kind:      st.Kind,
+			Replicas:  "-",
+			Status:    &st,
+		})
+	}
+
+	return res
+}
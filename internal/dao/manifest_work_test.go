@@ -0,0 +1,44 @@
+package dao
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestWorkManifests(t *testing.T) {
+	var mw render.ManifestWork
+	mw.Name, mw.Namespace = "fred", "c1"
+	mw.Spec.Workload.Manifests = []render.Manifest{
+		{RawExtension: runtime.RawExtension{Raw: []byte(`{"apiVersion":"apps/v1","kind":"Deployment","metadata":{"name":"blee","namespace":"default"},"spec":{"replicas":3}}`)}},
+		{RawExtension: runtime.RawExtension{Raw: []byte(`{"apiVersion":"v1","kind":"Service","metadata":{"name":"blee","namespace":"default"}}`)}},
+		{RawExtension: runtime.RawExtension{Raw: []byte(`{"kind":`)}},
+	}
+	mw.Status.ManifestStatuses = []render.ManifestStatus{
+		{Name: "blee", Namespace: "default", Kind: "Deployment"},
+		{Name: "zorg", Namespace: "default", Kind: "ConfigMap"},
+	}
+
+	oo := workManifests(&mw)
+	assert.Equal(t, 4, len(oo))
+
+	dp := oo[0].(render.ClusterManifestRes)
+	assert.Equal(t, "c1", dp.Cluster)
+	assert.Equal(t, "3", dp.Replicas)
+	assert.NotNil(t, dp.Status)
+	assert.NotNil(t, dp.Object)
+
+	svc := oo[1].(render.ClusterManifestRes)
+	assert.Equal(t, "Service", svc.Kind)
+	assert.Nil(t, svc.Status)
+
+	bad := oo[2].(render.ClusterManifestRes)
+	assert.Equal(t, "manifest-2", bad.Name)
+	assert.NotNil(t, bad.Err)
+
+	orphan := oo[3].(render.ClusterManifestRes)
+	assert.Equal(t, "zorg", orphan.Name)
+	assert.Nil(t, orphan.Object)
+}
@@ -0,0 +1,265 @@
+package dao
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"text/template"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/rs/zerolog/log"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+)
+
+// MemberContext represents the kube context of a fleet member cluster.
+type MemberContext struct {
+	// Cluster tracks the fleet cluster name.
+	Cluster string
+
+	// Context tracks the member cluster kube context name.
+	Context string
+
+	// KubeConfig tracks a kubeconfig path if the context is not part of the current kubeconfig.
+	KubeConfig string
+}
+
+// memberData represents a fleet cluster as seen by member context templates.
+type memberData struct {
+	Name           string
+	Labels         map[string]string
+	KeyIdentifiers map[string]string
+	Properties     map[string]string
+}
+
+func newMemberData(cl *render.Cluster) memberData {
+	d := memberData{
+		Name:           cl.Name,
+		Labels:         cl.Labels,
+		KeyIdentifiers: cl.Status.KeyIdentifier,
+		Properties:     make(map[string]string),
+	}
+	for _, s := range cl.Status.ProvisioningStatus {
+		for k, v := range s.Properties {
+			d.Properties[k] = v
+		}
+	}
+
+	return d
+}
+
+// ResolveMemberContext resolves the kube context of a given fleet cluster.
+func ResolveMemberContext(f Factory, cfg *config.MemberContexts, path string) (*MemberContext, error) {
+	cl, err := FetchFleetCluster(f, path)
+	if err != nil {
+		return nil, err
+	}
+	data := newMemberData(cl)
+	contexts, err := f.Client().Config().ContextNames()
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, err := resolveContext(cfg, data, contexts)
+	if err == nil {
+		return &MemberContext{Cluster: cl.Name, Context: ctx}, nil
+	}
+	if cfg.Secret == nil {
+		return nil, err
+	}
+
+	return secretMemberContext(f, cfg.Secret, data)
+}
+
+// resolveContext locates a member cluster context in the current kubeconfig.
+// Without explicit mappings, contexts named after the cluster or one of its key identifiers are used.
+func resolveContext(cfg *config.MemberContexts, data memberData, contexts []string) (string, error) {
+	known := make(map[string]struct{}, len(contexts))
+	for _, c := range contexts {
+		known[c] = struct{}{}
+	}
+	exists := func(c string) bool {
+		_, ok := known[c]
+		return ok
+	}
+
+	if c, ok := cfg.Contexts[data.Name]; ok {
+		if !exists(c) {
+			return "", fmt.Errorf("context %q for fleet cluster %q does not exist", c, data.Name)
+		}
+		return c, nil
+	}
+	if cfg.ContextTemplate != "" {
+		c, err := renderMemberTemplate(cfg.ContextTemplate, data)
+		if err != nil {
+			return "", err
+		}
+		if !exists(c) {
+			return "", fmt.Errorf("context %q for fleet cluster %q does not exist", c, data.Name)
+		}
+		return c, nil
+	}
+
+	if exists(data.Name) {
+		return data.Name, nil
+	}
+	ids := make([]string, 0, len(data.KeyIdentifiers))
+	for _, v := range data.KeyIdentifiers {
+		ids = append(ids, v)
+	}
+	sort.Strings(ids)
+	for _, id := range ids {
+		if exists(id) {
+			return id, nil
+		}
+	}
+
+	return "", fmt.Errorf("no kube context found for fleet cluster %q", data.Name)
+}
+
+// secretMemberContext extracts a member cluster kubeconfig from a hub Secret.
+func secretMemberContext(f Factory, s *config.KubeconfigSecret, data memberData) (*MemberContext, error) {
+	ns, err := renderMemberTemplate(s.Namespace, data)
+	if err != nil {
+		return nil, err
+	}
+	n, err := renderMemberTemplate(s.Name, data)
+	if err != nil {
+		return nil, err
+	}
+	o, err := f.Get("v1/secrets", client.FQN(ns, n), true, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	u, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return nil, fmt.Errorf("expecting unstructured but got %T", o)
+	}
+	enc, ok, err := unstructured.NestedString(u.Object, "data", s.SecretKey())
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, fmt.Errorf("no %q key found in secret %s", s.SecretKey(), client.FQN(ns, n))
+	}
+	raw, err := base64.StdEncoding.DecodeString(enc)
+	if err != nil {
+		return nil, err
+	}
+	kcfg, err := clientcmd.Load(raw)
+	if err != nil {
+		return nil, err
+	}
+	ctx, err := kubeConfigContext(kcfg.CurrentContext, kcfg.Contexts)
+	if err != nil {
+		return nil, fmt.Errorf("kubeconfig secret %s: %w", client.FQN(ns, n), err)
+	}
+	path, err := memberKubeConfigs.write(data.Name, raw)
+	if err != nil {
+		return nil, err
+	}
+
+	return &MemberContext{Cluster: data.Name, Context: ctx, KubeConfig: path}, nil
+}
+
+// kubeConfigContext returns a kubeconfig current context or its only context.
+func kubeConfigContext(current string, contexts map[string]*clientcmdapi.Context) (string, error) {
+	if current != "" {
+		return current, nil
+	}
+	switch len(contexts) {
+	case 0:
+		return "", errors.New("no context found")
+	case 1:
+		for c := range contexts {
+			return c, nil
+		}
+	}
+	cc := make([]string, 0, len(contexts))
+	for c := range contexts {
+		cc = append(cc, c)
+	}
+	sort.Strings(cc)
+
+	return "", fmt.Errorf("no current-context set and several contexts defined: %s", strings.Join(cc, ","))
+}
+
+// memberKubeConfigs tracks the temporary kubeconfig files extracted from hub Secrets.
+var memberKubeConfigs = kubeConfigFiles{files: make(map[string]kubeConfigFile)}
+
+type kubeConfigFile struct {
+	path string
+	sum  [sha256.Size]byte
+}
+
+type kubeConfigFiles struct {
+	sync.Mutex
+	files map[string]kubeConfigFile
+}
+
+// write stores a member cluster kubeconfig in a temporary file. The file is only
+// rewritten when its content changed.
+func (k *kubeConfigFiles) write(cluster string, raw []byte) (string, error) {
+	k.Lock()
+	defer k.Unlock()
+
+	sum := sha256.Sum256(raw)
+	f, ok := k.files[cluster]
+	if ok && f.sum == sum {
+		return f.path, nil
+	}
+	if !ok {
+		tmp, err := os.CreateTemp("", "k9s-fleet-"+config.SanitizeFilename(cluster)+"-*.kubeconfig")
+		if err != nil {
+			return "", err
+		}
+		if err := tmp.Close(); err != nil {
+			return "", err
+		}
+		f.path = tmp.Name()
+	}
+	if err := os.WriteFile(f.path, raw, 0600); err != nil {
+		return "", err
+	}
+	f.sum = sum
+	k.files[cluster] = f
+
+	return f.path, nil
+}
+
+// ClearMemberKubeConfigs removes the member cluster kubeconfig files extracted from hub Secrets.
+func ClearMemberKubeConfigs() {
+	memberKubeConfigs.Lock()
+	defer memberKubeConfigs.Unlock()
+
+	for cluster, f := range memberKubeConfigs.files {
+		if err := os.Remove(f.path); err != nil && !errors.Is(err, os.ErrNotExist) {
+			log.Warn().Err(err).Msgf("Unable to remove member kubeconfig %q", f.path)
+		}
+		dropMemberConnections(f.path)
+		delete(memberKubeConfigs.files, cluster)
+	}
+}
+
+func renderMemberTemplate(tpl string, data memberData) (string, error) {
+	t, err := template.New("member").Option("missingkey=zero").Parse(tpl)
+	if err != nil {
+		return "", err
+	}
+	var buff bytes.Buffer
+	if err := t.Execute(&buff, data); err != nil {
+		return "", err
+	}
+
+	return buff.String(), nil
+}
@@ -0,0 +1,115 @@
+package dao
+
+import (
+	"os"
+	"testing"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/stretchr/testify/assert"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+)
+
+func TestResolveContext(t *testing.T) {
+	data := memberData{
+		Name:           "c1",
+		Labels:         map[string]string{"region": "westus"},
+		KeyIdentifiers: map[string]string{"aks": "aks-c1", "arm": "arm-c1"},
+	}
+	contexts := []string{"hub", "c1", "aks-c1", "westus-c1"}
+
+	uu := map[string]struct {
+		cfg      config.MemberContexts
+		contexts []string
+		e        string
+		err      string
+	}{
+		"byName": {
+			contexts: contexts,
+			e:        "c1",
+		},
+		"byKeyIdentifier": {
+			contexts: []string{"hub", "aks-c1"},
+			e:        "aks-c1",
+		},
+		"explicit": {
+			cfg:      config.MemberContexts{Contexts: map[string]string{"c1": "hub"}},
+			contexts: contexts,
+			e:        "hub",
+		},
+		"explicitMissing": {
+			cfg:      config.MemberContexts{Contexts: map[string]string{"c1": "blee"}},
+			contexts: contexts,
+			err:      `context "blee" for fleet cluster "c1" does not exist`,
+		},
+		"template": {
+			cfg:      config.MemberContexts{ContextTemplate: `{{ index .Labels "region" }}-{{ .Name }}`},
+			contexts: contexts,
+			e:        "westus-c1",
+		},
+		"templateBroken": {
+			cfg:      config.MemberContexts{ContextTemplate: `{{ .Name`},
+			contexts: contexts,
+			err:      `template: member:1: unclosed action`,
+		},
+		"none": {
+			contexts: []string{"hub"},
+			err:      `no kube context found for fleet cluster "c1"`,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			ctx, err := resolveContext(&u.cfg, data, u.contexts)
+			if u.err != "" {
+				assert.EqualError(t, err, u.err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, u.e, ctx)
+		})
+	}
+}
+
+func TestKubeConfigContext(t *testing.T) {
+	ctx, err := kubeConfigContext("fred", nil)
+	assert.Nil(t, err)
+	assert.Equal(t, "fred", ctx)
+
+	ctx, err = kubeConfigContext("", map[string]*clientcmdapi.Context{"blee": {}})
+	assert.Nil(t, err)
+	assert.Equal(t, "blee", ctx)
+
+	_, err = kubeConfigContext("", nil)
+	assert.EqualError(t, err, "no context found")
+	_, err = kubeConfigContext("", map[string]*clientcmdapi.Context{"blee": {}, "duh": {}})
+	assert.EqualError(t, err, "no current-context set and several contexts defined: blee,duh")
+}
+
+func TestMemberKubeConfigs(t *testing.T) {
+	defer ClearMemberKubeConfigs()
+
+	p1, err := memberKubeConfigs.write("c1", []byte("blee"))
+	assert.Nil(t, err)
+	st1, err := os.Stat(p1)
+	assert.Nil(t, err)
+	assert.Equal(t, os.FileMode(0600), st1.Mode().Perm())
+
+	p2, err := memberKubeConfigs.write("c1", []byte("blee"))
+	assert.Nil(t, err)
+	assert.Equal(t, p1, p2)
+	st2, err := os.Stat(p2)
+	assert.Nil(t, err)
+	assert.Equal(t, st1.ModTime(), st2.ModTime())
+
+	p3, err := memberKubeConfigs.write("c1", []byte("duh"))
+	assert.Nil(t, err)
+	assert.Equal(t, p1, p3)
+	raw, err := os.ReadFile(p3)
+	assert.Nil(t, err)
+	assert.Equal(t, "duh", string(raw))
+
+	ClearMemberKubeConfigs()
+	_, err = os.Stat(p1)
+	assert.True(t, os.IsNotExist(err))
+}
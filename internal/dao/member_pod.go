@@ -0,0 +1,347 @@
+package dao
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/render"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	restclient "k8s.io/client-go/rest"
+)
+
+var (
+	_ Accessor = (*MemberPod)(nil)
+	_ Loggable = (*MemberPod)(nil)
+)
+
+const memberLogMergeWindow = 250 * time.Millisecond
+
+// MemberPod represents the pods of an application manifest across its member clusters.
+type MemberPod struct {
+	NonResource
+}
+
+// List returns the manifest pods found on each application cluster.
+func (m *MemberPod) List(ctx context.Context, _ string) ([]runtime.Object, error) {
+	fqn, ok := ctx.Value(internal.KeyPath).(string)
+	if !ok {
+		return nil, fmt.Errorf("no context path for %q", m.gvr)
+	}
+	id, ok := ctx.Value(internal.KeyManifest).(string)
+	if !ok || id == "" {
+		return nil, fmt.Errorf("no manifest specified for %q", m.gvr)
+	}
+	cfg := memberContexts(ctx)
+
+	app, err := FetchApplication(m.GetFactory(), fqn)
+	if err != nil {
+		return nil, err
+	}
+	mo, err := applicationManifest(app, id)
+	if err != nil {
+		return nil, err
+	}
+	sel, err := podSelector(mo)
+	if err != nil {
+		return nil, err
+	}
+	ns := render.GetNamespaceFromUnstructured(mo)
+
+	var (
+		mx  sync.Mutex
+		wg  sync.WaitGroup
+		res = make([]runtime.Object, 0, len(app.Status.Clusters))
+	)
+	for _, cs := range app.Status.Clusters {
+		wg.Add(1)
+		go func(cluster string) {
+			defer wg.Done()
+			oo, err := m.memberPods(ctx, cfg, cluster, ns, sel)
+			mx.Lock()
+			defer mx.Unlock()
+			if err != nil {
+				res = append(res, &render.MemberPodRes{Cluster: cluster, Err: err})
+				return
+			}
+			res = append(res, oo...)
+		}(cs.Cluster)
+	}
+	wg.Wait()
+	sort.SliceStable(res, func(i, j int) bool {
+		return res[i].(*render.MemberPodRes).Cluster < res[j].(*render.MemberPodRes).Cluster
+	})
+
+	return res, nil
+}
+
+func (m *MemberPod) memberPods(ctx context.Context, cfg *config.MemberContexts, cluster, ns string, sel labels.Selector) ([]runtime.Object, error) {
+	conn, err := memberConnection(m.GetFactory(), cfg, cluster)
+	if err != nil {
+		return nil, err
+	}
+	dial, err := conn.Dial()
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := context.WithTimeout(ctx, cfg.MemberTimeout())
+	defer cancel()
+	pp, err := dial.CoreV1().Pods(ns).List(ctx, metav1.ListOptions{LabelSelector: sel.String()})
+	if err != nil {
+		return nil, err
+	}
+
+	oo := make([]runtime.Object, 0, len(pp.Items))
+	for i := range pp.Items {
+		raw, err := runtime.DefaultUnstructuredConverter.ToUnstructured(&pp.Items[i])
+		if err != nil {
+			return nil, err
+		}
+		oo = append(oo, &render.MemberPodRes{
+			Cluster: cluster,
+			Pod:     &render.PodWithMetrics{Raw: &unstructured.Unstructured{Object: raw}},
+		})
+	}
+
+	return oo, nil
+}
+
+// TailLogs tails the logs of an application manifest pods across all member clusters.
+// The manifest is given by the options path and the member streams are merged by timestamp.
+func (m *MemberPod) TailLogs(ctx context.Context, opts *LogOptions) ([]LogChan, error) {
+	fqn, ok := ctx.Value(internal.KeyPath).(string)
+	if !ok {
+		return nil, fmt.Errorf("no context path for %q", m.gvr)
+	}
+	cfg := memberContexts(ctx)
+
+	app, err := FetchApplication(m.GetFactory(), fqn)
+	if err != nil {
+		return nil, err
+	}
+	mo, err := applicationManifest(app, opts.Path)
+	if err != nil {
+		return nil, err
+	}
+	sel, err := podSelector(mo)
+	if err != nil {
+		return nil, err
+	}
+	ns := render.GetNamespaceFromUnstructured(mo)
+
+	var (
+		in = make(LogChan, 2)
+		wg sync.WaitGroup
+	)
+	for _, cs := range app.Status.Clusters {
+		wg.Add(1)
+		go func(cluster string) {
+			defer wg.Done()
+			m.tailMember(ctx, &wg, in, cfg, cluster, ns, sel, opts)
+		}(cs.Cluster)
+	}
+	go func() {
+		wg.Wait()
+		close(in)
+	}()
+
+	return []LogChan{MergeLogs(ctx, in, memberLogMergeWindow)}, nil
+}
+
+func (m *MemberPod) tailMember(ctx context.Context, wg *sync.WaitGroup, out LogChan, cfg *config.MemberContexts, cluster, ns string, sel labels.Selector, opts *LogOptions) {
+	send := func(item *LogItem) bool {
+		if item != ItemEOF {
+			item.Cluster = cluster
+		}
+		select {
+		case <-ctx.Done():
+			return false
+		case out <- item:
+			return true
+		}
+	}
+
+	conn, err := memberConnection(m.GetFactory(), cfg, cluster)
+	if err != nil {
+		send(opts.ToErrLogItem(err))
+		return
+	}
+	dial, err := conn.Dial()
+	if err != nil {
+		send(opts.ToErrLogItem(err))
+		return
+	}
+	lctx, cancel := context.WithTimeout(ctx, cfg.MemberTimeout())
+	pp, err := dial.CoreV1().Pods(ns).List(lctx, metav1.ListOptions{LabelSelector: sel.String()})
+	cancel()
+	if err != nil {
+		send(opts.ToErrLogItem(err))
+		return
+	}
+
+	logger := memberLogger{conn: conn}
+	for _, po := range pp.Items {
+		for _, co := range po.Spec.Containers {
+			o := opts.Clone()
+			o.Path, o.Container = client.MetaFQN(po.ObjectMeta), co.Name
+			o.MultiPods, o.SingleContainer = true, len(po.Spec.Containers) == 1
+			c := tailLogs(ctx, &logger, o)
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				for item := range c {
+					if !send(item) {
+						return
+					}
+				}
+			}()
+		}
+	}
+}
+
+// memberLogger streams pod logs from a member cluster.
+type memberLogger struct {
+	conn client.Connection
+}
+
+// Logs tails a member cluster pod logs.
+func (m *memberLogger) Logs(path string, opts *v1.PodLogOptions) (*restclient.Request, error) {
+	dial, err := m.conn.DialLogs()
+	if err != nil {
+		return nil, err
+	}
+	ns, n := client.Namespaced(path)
+
+	return dial.CoreV1().Pods(ns).GetLogs(n, opts), nil
+}
+
+// applicationManifest locates an application workload manifest given its namespace/name_kind id.
+func applicationManifest(app *render.Application, id string) (*unstructured.Unstructured, error) {
+	_, mo, err := manifestIndex(app, id)
+
+	return mo, err
+}
+
+// manifestIndex locates an application workload manifest and its index given its namespace/name_kind id.
+func manifestIndex(app *render.Application, id string) (int, *unstructured.Unstructured, error) {
+	for i, m := range render.FleetObjects.Manifests(app) {
+		if m.Err != nil {
+			continue
+		}
+		if manifestID(m.Object) == id {
+			return i, m.Object, nil
+		}
+	}
+
+	return -1, nil, fmt.Errorf("no manifest %q found on application %q", id, client.FQN(app.Namespace, app.Name))
+}
+
+// manifestID returns a manifest namespace/name_kind identifier.
+func manifestID(mo *unstructured.Unstructured) string {
+	return client.FQN(render.GetNamespaceFromUnstructured(mo), render.GetNameFromUnstructured(mo)+"_"+render.GetKindFromUnstructured(mo))
+}
+
+// podSelector returns a workload manifest pod selector.
+func podSelector(mo *unstructured.Unstructured) (labels.Selector, error) {
+	raw, ok, err := unstructured.NestedMap(mo.Object, "spec", "selector")
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, fmt.Errorf("manifest %s has no pod selector", render.GetNameFromUnstructured(mo))
+	}
+	var ls metav1.LabelSelector
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(raw, &ls); err != nil {
+		return nil, err
+	}
+
+	return metav1.LabelSelectorAsSelector(&ls)
+}
+
+// memberConns caches member cluster connections keyed by kubeconfig and context.
+// Dials are serialized per key so concurrent lookups share a single connection.
+var memberConns = struct {
+	sync.Mutex
+	conns map[string]client.Connection
+	dials map[string]*sync.Mutex
+}{
+	conns: make(map[string]client.Connection),
+	dials: make(map[string]*sync.Mutex),
+}
+
+// dropMemberConnections evicts the member connections loaded from a given kubeconfig.
+func dropMemberConnections(kubeConfig string) {
+	memberConns.Lock()
+	defer memberConns.Unlock()
+	for k := range memberConns.conns {
+		if strings.HasPrefix(k, kubeConfig+":") {
+			delete(memberConns.conns, k)
+		}
+	}
+	for k := range memberConns.dials {
+		if strings.HasPrefix(k, kubeConfig+":") {
+			delete(memberConns.dials, k)
+		}
+	}
+}
+
+// memberConnection returns a connection to a fleet member cluster.
+func memberConnection(f Factory, cfg *config.MemberContexts, cluster string) (client.Connection, error) {
+	mc, err := ResolveMemberContext(f, cfg, client.FQN(client.ClusterScope, cluster))
+	if err != nil {
+		return nil, err
+	}
+	kubeConfig := f.Client().Config().Flags().KubeConfig
+	if mc.KubeConfig != "" {
+		kubeConfig = &mc.KubeConfig
+	}
+	var key string
+	if kubeConfig != nil {
+		key = *kubeConfig
+	}
+	key += ":" + mc.Context
+
+	memberConns.Lock()
+	dial, ok := memberConns.dials[key]
+	if !ok {
+		dial = new(sync.Mutex)
+		memberConns.dials[key] = dial
+	}
+	memberConns.Unlock()
+	dial.Lock()
+	defer dial.Unlock()
+
+	memberConns.Lock()
+	conn, ok := memberConns.conns[key]
+	memberConns.Unlock()
+	if ok && conn.ConnectionOK() {
+		return conn, nil
+	}
+
+	flags := genericclioptions.NewConfigFlags(client.UsePersistentConfig)
+	timeout := cfg.MemberTimeout().String()
+	flags.KubeConfig, flags.Context, flags.Timeout = kubeConfig, &mc.Context, &timeout
+	conn, err = client.InitConnection(client.NewConfig(flags))
+	if err != nil {
+		return nil, fmt.Errorf("unable to connect to cluster %q: %w", cluster, err)
+	}
+	if !conn.CheckConnectivity() {
+		return nil, fmt.Errorf("unable to connect to cluster %q", cluster)
+	}
+	memberConns.Lock()
+	memberConns.conns[key] = conn
+	memberConns.Unlock()
+
+	return conn, nil
+}
@@ -0,0 +1,59 @@
+package dao
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestApplicationManifestSelector(t *testing.T) {
+	var app render.Application
+	app.Namespace, app.Name = "fleet", "app1"
+	for _, raw := range []string{
+		`{"apiVersion":"v1","kind":"Service","metadata":{"name":"web","namespace":"default"}}`,
+		`{"apiVersion":"apps/v1","kind":"Deployment","metadata":{"name":"web","namespace":"default"},"spec":{"selector":{"matchLabels":{"app":"web"},"matchExpressions":[{"key":"tier","operator":"In","values":["fe"]}]}}}`,
+	} {
+		app.Spec.Workload = append(app.Spec.Workload, render.ManifestWithStrategy{
+			ManifestItem: render.Manifest{RawExtension: runtime.RawExtension{Raw: []byte(raw)}},
+		})
+	}
+
+	uu := map[string]struct {
+		id, sel, err string
+	}{
+		"deployment": {
+			id:  "default/web_Deployment",
+			sel: "app=web,tier in (fe)",
+		},
+		"noSelector": {
+			id:  "default/web_Service",
+			err: "manifest web has no pod selector",
+		},
+		"missing": {
+			id:  "default/blee_Deployment",
+			err: `no manifest "default/blee_Deployment" found on application "fleet/app1"`,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			mo, err := applicationManifest(&app, u.id)
+			if err == nil {
+				var sel labels.Selector
+				sel, err = podSelector(mo)
+				if err == nil {
+					assert.Equal(t, u.sel, sel.String())
+				}
+			}
+			if u.err != "" {
+				assert.EqualError(t, err, u.err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,25 @@
+package dao
+
+import (
+	"context"
+
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+var _ Accessor = (*Notification)(nil)
+
+// Notification represents the fleet notifications history.
+type Notification struct {
+	NonResource
+}
+
+// List returns the session fleet notifications, latest first.
+func (n *Notification) List(context.Context, string) ([]runtime.Object, error) {
+	nn := FleetNotifier.Notifications()
+	oo := make([]runtime.Object, 0, len(nn))
+	for i := range nn {
+		oo = append(oo, &nn[i])
+	}
+
+	return oo, nil
+}
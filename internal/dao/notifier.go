@@ -0,0 +1,276 @@
+package dao
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os/exec"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/rs/zerolog/log"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/tools/cache"
+)
+
+// MaxNotifications tracks the number of notifications kept in history.
+const MaxNotifications = 500
+
+// FleetNotifier tracks the fleet notifications issued during the session.
+var FleetNotifier = NewNotifier(config.NewFleetNotifications())
+
+// NotificationListener represents a fleet notification listener.
+type NotificationListener interface {
+	// FleetNotified notifies a fleet state transition matched a rule.
+	FleetNotified(render.FleetNotification)
+}
+
+// Notifier watches fleet resources informers and notifies on configured state transitions.
+type Notifier struct {
+	rules     []config.NotificationRule
+	hook      *config.NotificationHook
+	context   string
+	history   []render.FleetNotification
+	seq       int
+	listeners []NotificationListener
+	informers map[cache.SharedIndexInformer]struct{}
+	mx        sync.RWMutex
+}
+
+// NewNotifier returns a new fleet notifier.
+func NewNotifier(cfg *config.FleetNotifications) *Notifier {
+	n := Notifier{informers: make(map[cache.SharedIndexInformer]struct{})}
+	n.Configure(cfg)
+
+	return &n
+}
+
+// Configure sets the notification rules and hook.
+func (n *Notifier) Configure(cfg *config.FleetNotifications) {
+	n.mx.Lock()
+	defer n.mx.Unlock()
+	n.rules, n.hook = cfg.Notifications.Rules, cfg.Notifications.Hook
+}
+
+// AddListener registers a new notification listener.
+func (n *Notifier) AddListener(l NotificationListener) {
+	n.mx.Lock()
+	defer n.mx.Unlock()
+	n.listeners = append(n.listeners, l)
+}
+
+// RemoveListener unregisters a notification listener.
+func (n *Notifier) RemoveListener(l NotificationListener) {
+	n.mx.Lock()
+	defer n.mx.Unlock()
+	for i, lis := range n.listeners {
+		if lis == l {
+			n.listeners = append(n.listeners[:i], n.listeners[i+1:]...)
+			return
+		}
+	}
+}
+
+// Watch hooks the notifier to the fleet resources informers of a factory.
+// Only resources with rules and known to the cluster are watched.
+func (n *Notifier) Watch(f Factory) {
+	n.mx.Lock()
+	n.context, _ = f.Client().Config().CurrentContextName()
+	kinds := make(map[string]struct{})
+	for _, r := range n.rules {
+		kinds[r.Kind] = struct{}{}
+	}
+	n.mx.Unlock()
+
+	for kind := range kinds {
+		gvr := applicationGVR
+		if kind == config.NotifyCluster {
+			gvr = fleetClusterGVR
+		}
+		if _, err := MetaAccess.MetaFor(client.NewGVR(gvr)); err != nil {
+			continue
+		}
+		n.watch(f, gvr)
+	}
+}
+
+func (n *Notifier) watch(f Factory, gvr string) {
+	inf, err := f.ForResource(client.AllNamespaces, gvr)
+	if err != nil || inf == nil {
+		log.Warn().Err(err).Msgf("Fleet notifications unable to watch %q", gvr)
+		return
+	}
+	feedFleetCache(f, client.AllNamespaces, gvr)
+	i := inf.Informer()
+
+	n.mx.Lock()
+	defer n.mx.Unlock()
+	if _, ok := n.informers[i]; ok {
+		return
+	}
+	_, err = i.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		UpdateFunc: n.update,
+	})
+	if err != nil {
+		log.Warn().Err(err).Msgf("Fleet notifications watch failed for %q", gvr)
+		return
+	}
+	n.informers[i] = struct{}{}
+}
+
+// Notifications returns the notifications history, latest first.
+func (n *Notifier) Notifications() []render.FleetNotification {
+	n.mx.RLock()
+	defer n.mx.RUnlock()
+
+	nn := make([]render.FleetNotification, 0, len(n.history))
+	for i := len(n.history) - 1; i >= 0; i-- {
+		nn = append(nn, n.history[i])
+	}
+
+	return nn
+}
+
+// Clear clears out the notifications history.
+func (n *Notifier) Clear() {
+	n.mx.Lock()
+	defer n.mx.Unlock()
+	n.history = nil
+}
+
+func (n *Notifier) update(prev, curr interface{}) {
+	o, ok1 := prev.(*unstructured.Unstructured)
+	c, ok2 := curr.(*unstructured.Unstructured)
+	if !ok1 || !ok2 {
+		return
+	}
+	from, to := notificationFields(o), notificationFields(c)
+	for field, v := range to {
+		n.Evaluate(c.GetKind(), c.GetNamespace(), c.GetName(), field, from[field], v)
+	}
+}
+
+// Evaluate notifies on a field transition if it matches a rule. The first matching rule wins.
+func (n *Notifier) Evaluate(kind, ns, name, field, from, to string) {
+	n.mx.Lock()
+	var notif *render.FleetNotification
+	for _, r := range n.rules {
+		if !r.Matches(kind, field, from, to) {
+			continue
+		}
+		n.seq++
+		notif = &render.FleetNotification{
+			ID:        n.seq,
+			Time:      time.Now(),
+			Level:     notificationLevel(r.Level),
+			Rule:      r.Name,
+			Context:   n.context,
+			Kind:      kind,
+			Namespace: ns,
+			Name:      name,
+			Field:     field,
+			From:      from,
+			To:        to,
+		}
+		break
+	}
+	if notif == nil {
+		n.mx.Unlock()
+		return
+	}
+	n.history = append(n.history, *notif)
+	if len(n.history) > MaxNotifications {
+		n.history = n.history[len(n.history)-MaxNotifications:]
+	}
+	hook := n.hook
+	ll := make([]NotificationListener, len(n.listeners))
+	copy(ll, n.listeners)
+	n.mx.Unlock()
+
+	for _, l := range ll {
+		l.FleetNotified(*notif)
+	}
+	if hook != nil && hook.Command != "" {
+		go n.runHook(hook, *notif)
+	}
+}
+
+// runHook runs the notification hook with the notification as JSON on stdin.
+func (n *Notifier) runHook(h *config.NotificationHook, notif render.FleetNotification) {
+	status := "ok"
+	if err := RunNotificationHook(h, &notif); err != nil {
+		log.Error().Err(err).Msgf("Notification hook %q failed", h.Command)
+		status = "failed"
+	}
+
+	n.mx.Lock()
+	defer n.mx.Unlock()
+	for i := range n.history {
+		if n.history[i].ID == notif.ID {
+			n.history[i].Hook = status
+			return
+		}
+	}
+}
+
+// RunNotificationHook runs a notification hook command feeding it the notification as JSON on stdin.
+func RunNotificationHook(h *config.NotificationHook, notif *render.FleetNotification) error {
+	raw, err := json.Marshal(notif)
+	if err != nil {
+		return err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), h.HookTimeout())
+	defer cancel()
+
+	// nolint:gosec
+	cmd := exec.CommandContext(ctx, h.Command, h.Args...)
+	cmd.Stdin = bytes.NewReader(raw)
+	out, err := cmd.CombinedOutput()
+	if err != nil {
+		return fmt.Errorf("%w: %s", err, strings.TrimSpace(string(out)))
+	}
+
+	return nil
+}
+
+// notificationFields returns the watched fields values of a fleet object.
+func notificationFields(u *unstructured.Unstructured) map[string]string {
+	switch u.GetKind() {
+	case config.NotifyCluster:
+		cl, err := render.FleetObjects.Cluster(u)
+		if err != nil {
+			return nil
+		}
+		return map[string]string{
+			"health":   string(cl.Status.ClusterHealthStatus),
+			"activity": string(cl.Status.ClusterActivityStatus),
+		}
+	case config.NotifyApplication:
+		app, err := render.FleetObjects.Application(u)
+		if err != nil {
+			return nil
+		}
+		return map[string]string{
+			"rollout": string(app.Status.RolloutStatus),
+			"state":   string(app.Status.ApplicationState),
+		}
+	default:
+		return nil
+	}
+}
+
+func notificationLevel(l string) string {
+	switch strings.ToLower(l) {
+	case render.NotifyError, "err":
+		return render.NotifyError
+	case render.NotifyWarn, "warning":
+		return render.NotifyWarn
+	default:
+		return render.NotifyInfo
+	}
+}
@@ -0,0 +1,65 @@
+package dao
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+)
+
+type notificationListener struct {
+	nn []render.FleetNotification
+}
+
+func (l *notificationListener) FleetNotified(n render.FleetNotification) {
+	l.nn = append(l.nn, n)
+}
+
+func TestNotifierEvaluate(t *testing.T) {
+	n := NewNotifier(config.NewFleetNotifications())
+	var l notificationListener
+	n.AddListener(&l)
+
+	n.Evaluate(config.NotifyCluster, "", "c1", "health", "Healthy", "Failed")
+	n.Evaluate(config.NotifyCluster, "", "c1", "health", "Failed", "Failed")
+	n.Evaluate(config.NotifyCluster, "", "c2", "activity", "Idle", "Failed")
+	n.Evaluate(config.NotifyApplication, "fred", "a1", "rollout", "RollingOut", "RollingBack")
+
+	assert.Equal(t, 2, len(l.nn))
+	nn := n.Notifications()
+	assert.Equal(t, 2, len(nn))
+	assert.Equal(t, "fred/a1", nn[0].FQN())
+	assert.Equal(t, render.NotifyError, nn[0].Level)
+	assert.Equal(t, "application-rolling-back", nn[0].Rule)
+	assert.Equal(t, "Cluster c1 health changed Healthy -> Failed", nn[1].Message())
+
+	n.RemoveListener(&l)
+	n.Evaluate(config.NotifyCluster, "", "c3", "health", "Healthy", "Partial-Failed")
+	assert.Equal(t, 2, len(l.nn))
+	assert.Equal(t, render.NotifyWarn, n.Notifications()[0].Level)
+
+	n.Clear()
+	assert.Equal(t, 0, len(n.Notifications()))
+}
+
+func TestNotifierHistoryCap(t *testing.T) {
+	n := NewNotifier(&config.FleetNotifications{Notifications: config.Notifications{
+		Rules: []config.NotificationRule{{Name: "any", Kind: config.NotifyCluster, Field: "health"}},
+	}})
+	for i := 0; i < MaxNotifications+10; i++ {
+		n.Evaluate(config.NotifyCluster, "", "c1", "health", "Healthy", "Failed")
+	}
+
+	nn := n.Notifications()
+	assert.Equal(t, MaxNotifications, len(nn))
+	assert.Equal(t, MaxNotifications+10, nn[0].ID)
+	assert.Equal(t, render.NotifyInfo, nn[0].Level)
+}
+
+func TestRunNotificationHook(t *testing.T) {
+	n := render.FleetNotification{Kind: config.NotifyCluster, Name: "c1", Field: "health", To: "Failed"}
+
+	assert.Nil(t, RunNotificationHook(&config.NotificationHook{Command: "sh", Args: []string{"-c", `grep -q '"name":"c1"'`}}, &n))
+	assert.Error(t, RunNotificationHook(&config.NotificationHook{Command: "sh", Args: []string{"-c", `grep -q '"name":"c2"'`}}, &n))
+}
@@ -0,0 +1,53 @@
+package dao
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/render"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+var _ Accessor = (*Placement)(nil)
+
+// Placement represents an application placement preview across fleet clusters.
+type Placement struct {
+	NonResource
+}
+
+// List evaluates the application required capabilities against every fleet cluster.
+// Capabilities carried by the context override the application cluster selectors.
+func (p *Placement) List(ctx context.Context, _ string) ([]runtime.Object, error) {
+	required, ok := ctx.Value(internal.KeyCapabilities).([]string)
+	if !ok {
+		fqn, ok := ctx.Value(internal.KeyPath).(string)
+		if !ok {
+			return nil, fmt.Errorf("no context path for %q", p.gvr)
+		}
+		app, err := FetchApplication(p.GetFactory(), fqn)
+		if err != nil {
+			return nil, err
+		}
+		required = app.Spec.ClusterSelectors.Capabilities
+	}
+
+	cc, err := FetchFleetClusters(p.GetFactory())
+	if err != nil {
+		return nil, err
+	}
+	res := make([]runtime.Object, 0, len(cc))
+	for _, cl := range cc {
+		res = append(res, &render.PlacementRes{
+			Cluster:  cl.Name,
+			Required: required,
+			Failures: render.EvaluatePlacement(required, cl.Status.RuntimeStatus),
+		})
+	}
+	sort.SliceStable(res, func(i, j int) bool {
+		return res[i].(*render.PlacementRes).Cluster < res[j].(*render.PlacementRes).Cluster
+	})
+
+	return res, nil
+}
@@ -0,0 +1,85 @@
+package dao
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/rs/zerolog/log"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+var _ Accessor = (*ProvisioningStep)(nil)
+
+// ProvisioningStep represents a fleet cluster provisioning steps.
+type ProvisioningStep struct {
+	NonResource
+}
+
+// List returns a fleet cluster provisioning steps ordered by its cluster definition tasks.
+func (p *ProvisioningStep) List(ctx context.Context, _ string) ([]runtime.Object, error) {
+	path, ok := ctx.Value(internal.KeyPath).(string)
+	if !ok || path == "" {
+		return nil, fmt.Errorf("no context path for %q", p.gvr)
+	}
+
+	cl, err := FetchFleetCluster(p.GetFactory(), path)
+	if err != nil {
+		return nil, err
+	}
+	def, err := ClusterDefinitionFor(p.GetFactory(), cl)
+	if err != nil {
+		log.Warn().Err(err).Msgf("Unable to resolve cluster definition for %q", path)
+	}
+
+	return provisioningSteps(def, cl.Status.ProvisioningStatus), nil
+}
+
+func provisioningSteps(def *render.ClusterDefinition, ss []render.StepStatus) []runtime.Object {
+	steps := make(map[string]render.StepStatus, len(ss))
+	for _, s := range ss {
+		steps[s.Name] = s
+	}
+
+	oo := make([]runtime.Object, 0, len(ss))
+	if def != nil {
+		for _, ph := range render.DefinitionPhases(def.Spec) {
+			for i := range ph.Tasks {
+				t := ph.Tasks[i]
+				res := render.ProvisioningStepRes{
+					Index:  len(oo) + 1,
+					Phase:  ph.Name,
+					Name:   t.Name,
+					Task:   &t,
+					Inputs: render.ResolveTaskInputs(t, steps),
+				}
+				if s, ok := steps[t.Name]; ok {
+					res.Step = &s
+				}
+				oo = append(oo, res)
+			}
+		}
+	}
+
+	// Steps reported by the provisioner that are not part of the definition.
+	seen := make(map[string]struct{}, len(oo))
+	for _, o := range oo {
+		seen[o.(render.ProvisioningStepRes).Name] = struct{}{}
+	}
+	for i := range ss {
+		s := ss[i]
+		if _, ok := seen[s.Name]; ok {
+			continue
+		}
+		seen[s.Name] = struct{}{}
+		oo = append(oo, render.ProvisioningStepRes{
+			Index: len(oo) + 1,
+			Phase: render.NAValue,
+			Name:  s.Name,
+			Step:  &s,
+		})
+	}
+
+	return oo
+}
@@ -0,0 +1,71 @@
+package dao
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProvisioningSteps(t *testing.T) {
+	def := render.ClusterDefinition{
+		Spec: render.ClusterDefinitionSpec{
+			PreTasks: []render.ClusterDefinitionTask{
+				{Name: "network", TaskType: "Vnet"},
+			},
+			Tasks: []render.ClusterDefinitionTask{
+				{
+					Name:     "aks",
+					TaskType: "Aks",
+					Properties: map[string]render.ClusterDefinitionTaskPropertyValue{
+						"subnet": {ValueFrom: render.ClusterDefinitionTaskRef{TaskName: "network", OutputProperty: "subnetId"}},
+					},
+				},
+			},
+			PostTasks: []render.ClusterDefinitionTask{
+				{Name: "register", TaskType: "Register"},
+			},
+		},
+	}
+	ss := []render.StepStatus{
+		{Name: "aks", Status: "Running"},
+		{Name: "network", Status: "Completed", Properties: map[string]string{"subnetId": "s1"}},
+		{Name: "cleanup", Type: "Cleanup", Status: "Failed"},
+	}
+
+	oo := provisioningSteps(&def, ss)
+	assert.Equal(t, 4, len(oo))
+
+	e := []struct {
+		index       int
+		phase, name string
+		hasStep     bool
+	}{
+		{1, render.PreTasksPhase, "network", true},
+		{2, render.TasksPhase, "aks", true},
+		{3, render.PostTasksPhase, "register", false},
+		{4, render.NAValue, "cleanup", true},
+	}
+	for i, o := range oo {
+		s := o.(render.ProvisioningStepRes)
+		assert.Equal(t, e[i].index, s.Index)
+		assert.Equal(t, e[i].phase, s.Phase)
+		assert.Equal(t, e[i].name, s.Name)
+		assert.Equal(t, e[i].hasStep, s.Step != nil)
+	}
+	aks := oo[1].(render.ProvisioningStepRes)
+	assert.Equal(t, 1, len(aks.Inputs))
+	assert.Equal(t, "subnet<-network.subnetId=s1", aks.Inputs[0].String())
+}
+
+func TestProvisioningStepsNoDefinition(t *testing.T) {
+	ss := []render.StepStatus{
+		{Name: "a", Status: "Completed"},
+		{Name: "a", Status: "Completed"},
+		{Name: "b", Status: "Running"},
+	}
+
+	oo := provisioningSteps(nil, ss)
+	assert.Equal(t, 2, len(oo))
+	assert.Equal(t, "b", oo[1].(render.ProvisioningStepRes).Name)
+}
@@ -93,8 +93,22 @@ func AccessorFor(f Factory, gvr client.GVR) (Accessor, error) {
 		client.NewGVR("batch/v1/jobs"):                              &Job{},
 		client.NewGVR("v1/namespaces"):                              &Namespace{},
 		client.NewGVR("apis.clusterfleet.io/v1alpha1/applications"): &Application{},
+		client.NewGVR("apis.clusterfleet.io/v1alpha1/clusters"):     &FleetClusters{},
+		client.NewGVR(fleetDisruptionGVR):                           &FleetDisruption{},
+		client.NewGVR(ClusterDefinitionGVR):                         &ClusterDefinition{},
 		client.NewGVR("manifests"):                                  &Manifest{},
 		client.NewGVR("applicationStatus"):                          &ApplicationStatus{},
+		client.NewGVR("clusterManifests"):                           &ClusterManifest{},
+		client.NewGVR("memberPods"):                                 &MemberPod{},
+		client.NewGVR("drifts"):                                     &Drift{},
+		client.NewGVR("placements"):                                 &Placement{},
+		client.NewGVR("notifications"):                              &Notification{},
+		client.NewGVR("healthpolicies"):                             &HealthPolicy{},
+		client.NewGVR("provisioningSteps"):                          &ProvisioningStep{},
+		client.NewGVR("rollouts"):                                   &Rollout{},
+		client.NewGVR("serviceMembers"):                             &ServiceMember{},
+		client.NewGVR("syncerMembers"):                              &SyncerMember{},
+		client.NewGVR("workManifests"):                              &WorkManifest{},
 		// BOZO!! Revamp with latest...
 		// client.NewGVR("openfaas"):               &OpenFaas{},
 		client.NewGVR("popeye"):    &Popeye{},
@@ -213,6 +227,13 @@ func loadK9s(m ResourceMetas) {
 		ShortNames:   []string{"hz", "pu"},
 		Categories:   []string{"k9s"},
 	}
+	m[client.NewGVR("fleetpulses")] = metav1.APIResource{
+		Name:         "fleetpulses",
+		Kind:         "FleetPulse",
+		SingularName: "fleetpulses",
+		ShortNames:   []string{"fpu"},
+		Categories:   []string{"k9s"},
+	}
 	m[client.NewGVR("dir")] = metav1.APIResource{
 		Name:         "dir",
 		Kind:         "Dir",
@@ -302,6 +323,14 @@ func loadK9s(m ResourceMetas) {
 		Verbs:        []string{},
 		Categories:   []string{"k9s"},
 	}
+	m[client.NewGVR("liveObjects")] = metav1.APIResource{
+		Name:         "liveObjects",
+		Kind:         "liveObjects",
+		SingularName: "liveObject",
+		Namespaced:   true,
+		Verbs:        []string{},
+		Categories:   []string{"k9s"},
+	}
 	m[client.NewGVR("applicationStatus")] = metav1.APIResource{
 		Name:         "applicationStatus",
 		Kind:         "applicationStatus",
@@ -309,6 +338,84 @@ func loadK9s(m ResourceMetas) {
 		Verbs:        []string{},
 		Categories:   []string{"k9s"},
 	}
+	m[client.NewGVR("clusterManifests")] = metav1.APIResource{
+		Name:         "clusterManifests",
+		Kind:         "clusterManifests",
+		SingularName: "clusterManifest",
+		Verbs:        []string{},
+		Categories:   []string{"k9s"},
+	}
+	m[client.NewGVR("memberPods")] = metav1.APIResource{
+		Name:         "memberPods",
+		Kind:         "memberPods",
+		SingularName: "memberPod",
+		Verbs:        []string{},
+		Categories:   []string{"k9s"},
+	}
+	m[client.NewGVR("placements")] = metav1.APIResource{
+		Name:         "placements",
+		Kind:         "placements",
+		SingularName: "placement",
+		Verbs:        []string{},
+		Categories:   []string{"k9s"},
+	}
+	m[client.NewGVR("notifications")] = metav1.APIResource{
+		Name:         "notifications",
+		Kind:         "Notifications",
+		SingularName: "notification",
+		ShortNames:   []string{"notif"},
+		Verbs:        []string{},
+		Categories:   []string{"k9s"},
+	}
+	m[client.NewGVR("drifts")] = metav1.APIResource{
+		Name:         "drifts",
+		Kind:         "drifts",
+		SingularName: "drift",
+		Verbs:        []string{},
+		Categories:   []string{"k9s"},
+	}
+	m[client.NewGVR("healthpolicies")] = metav1.APIResource{
+		Name:         "healthpolicies",
+		Kind:         "HealthPolicies",
+		SingularName: "healthpolicy",
+		Verbs:        []string{},
+		Categories:   []string{"k9s"},
+	}
+	m[client.NewGVR("provisioningSteps")] = metav1.APIResource{
+		Name:         "provisioningSteps",
+		Kind:         "ProvisioningSteps",
+		SingularName: "provisioningStep",
+		Verbs:        []string{},
+		Categories:   []string{"k9s"},
+	}
+	m[client.NewGVR("workManifests")] = metav1.APIResource{
+		Name:         "workManifests",
+		Kind:         "WorkManifests",
+		SingularName: "workManifest",
+		Verbs:        []string{},
+		Categories:   []string{"k9s"},
+	}
+	m[client.NewGVR("serviceMembers")] = metav1.APIResource{
+		Name:         "serviceMembers",
+		Kind:         "ServiceMembers",
+		SingularName: "serviceMember",
+		Verbs:        []string{},
+		Categories:   []string{"k9s"},
+	}
+	m[client.NewGVR("syncerMembers")] = metav1.APIResource{
+		Name:         "syncerMembers",
+		Kind:         "SyncerMembers",
+		SingularName: "syncerMember",
+		Verbs:        []string{},
+		Categories:   []string{"k9s"},
+	}
+	m[client.NewGVR("rollouts")] = metav1.APIResource{
+		Name:         "rollouts",
+		Kind:         "Rollouts",
+		SingularName: "rollout",
+		Verbs:        []string{},
+		Categories:   []string{"k9s"},
+	}
 }
 
 func loadHelm(m ResourceMetas) {
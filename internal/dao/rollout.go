@@ -0,0 +1,102 @@
+package dao
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/rs/zerolog/log"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+var _ Accessor = (*Rollout)(nil)
+
+// Rollout represents an application rollout progress across its target clusters.
+type Rollout struct {
+	NonResource
+}
+
+// List returns an application rollout progress per target cluster.
+func (r *Rollout) List(ctx context.Context, _ string) ([]runtime.Object, error) {
+	fqn, ok := ctx.Value(internal.KeyPath).(string)
+	if !ok || fqn == "" {
+		return nil, fmt.Errorf("no context path for %q", r.gvr)
+	}
+
+	app, err := FetchApplication(r.GetFactory(), fqn)
+	if err != nil {
+		return nil, err
+	}
+	budget, err := fetchRolloutBudget(r.GetFactory(), app)
+	if err != nil {
+		log.Debug().Err(err).Msgf("Unable to resolve rollout disruption budget for %q", fqn)
+	}
+	oo := make([]runtime.Object, 0, len(app.Status.Clusters))
+	for _, cs := range app.Status.Clusters {
+		oo = append(oo, render.RolloutRes{Generation: app.Generation, Status: cs, Budget: budget})
+	}
+
+	return oo, nil
+}
+
+// fetchRolloutBudget returns the most restrictive fleet disruption budget selecting any of the application clusters
+// or nil if none applies.
+func fetchRolloutBudget(f Factory, app *render.Application) (*render.RolloutBudget, error) {
+	oo, err := f.List(fleetDisruptionGVR, app.Namespace, true, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	dd := make([]render.FleetDisruption, 0, len(oo))
+	for _, o := range oo {
+		u, ok := o.(*unstructured.Unstructured)
+		if !ok {
+			return nil, fmt.Errorf("expecting unstructured but got %T", o)
+		}
+		var fd render.FleetDisruption
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, &fd); err != nil {
+			return nil, err
+		}
+		dd = append(dd, fd)
+	}
+	cc, err := FetchFleetClusters(f)
+	if err != nil {
+		return nil, err
+	}
+
+	return rolloutBudget(app, dd, cc), nil
+}
+
+func rolloutBudget(app *render.Application, dd []render.FleetDisruption, cc []render.Cluster) *render.RolloutBudget {
+	scheduled := make(map[string]struct{}, len(app.Status.Clusters))
+	for _, c := range app.Status.Clusters {
+		scheduled[c.Cluster] = struct{}{}
+	}
+	appClusters := make([]render.Cluster, 0, len(scheduled))
+	for _, c := range cc {
+		if _, ok := scheduled[c.Name]; ok {
+			appClusters = append(appClusters, c)
+		}
+	}
+
+	var budget *render.RolloutBudget
+	for _, fd := range dd {
+		mm := matchingClusters(fd.Spec.ClusterSelector, appClusters)
+		if len(mm) == 0 {
+			continue
+		}
+		allowed, err := render.AllowedUnavailable(fd.Spec.MaxUnavailable, len(mm))
+		if err != nil {
+			log.Warn().Err(err).Msgf("Skipping fleet disruption %s", client.FQN(fd.Namespace, fd.Name))
+			continue
+		}
+		if budget == nil || allowed < budget.Allowed {
+			budget = &render.RolloutBudget{Name: fd.Name, MaxUnavailable: fd.Spec.MaxUnavailable, Allowed: allowed}
+		}
+	}
+
+	return budget
+}
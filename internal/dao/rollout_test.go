@@ -0,0 +1,55 @@
+package dao
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRolloutBudget(t *testing.T) {
+	cc := []render.Cluster{
+		makeLabeledCluster("c1", map[string]string{"region": "east"}),
+		makeLabeledCluster("c2", map[string]string{"region": "east"}),
+		makeLabeledCluster("c3", map[string]string{"region": "east"}),
+		makeLabeledCluster("c4", map[string]string{"region": "west"}),
+	}
+	app := makeFleetApp("default", "a1", "c1", "c2", "c3")
+
+	uu := map[string]struct {
+		dd []render.FleetDisruption
+		e  *render.RolloutBudget
+	}{
+		"none": {},
+		"no-match": {
+			dd: []render.FleetDisruption{makeDisruption("west", map[string]string{"region": "west"}, "1")},
+		},
+		"percent": {
+			dd: []render.FleetDisruption{makeDisruption("east", map[string]string{"region": "east"}, "50%")},
+			e:  &render.RolloutBudget{Name: "east", MaxUnavailable: "50%", Allowed: 2},
+		},
+		"most-restrictive": {
+			dd: []render.FleetDisruption{
+				makeDisruption("east", map[string]string{"region": "east"}, "50%"),
+				makeDisruption("all", nil, "1"),
+				makeDisruption("bad", nil, "blee"),
+			},
+			e: &render.RolloutBudget{Name: "all", MaxUnavailable: "1", Allowed: 1},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, rolloutBudget(&app, u.dd, cc))
+		})
+	}
+}
+
+func makeDisruption(n string, sel map[string]string, maxUnavailable string) render.FleetDisruption {
+	var fd render.FleetDisruption
+	fd.Namespace, fd.Name = "default", n
+	fd.Spec.ClusterSelector, fd.Spec.MaxUnavailable = sel, maxUnavailable
+
+	return fd
+}
@@ -90,6 +90,24 @@ type NodeMaintainer interface {
 	Drain(path string, opts DrainOptions, w io.Writer) error
 }
 
+// FleetClusterMaintainer performs fleet cluster maintenance operations.
+type FleetClusterMaintainer interface {
+	// ToggleDrain toggles drain/activate a fleet cluster.
+	ToggleDrain(path string, drain bool) error
+
+	// ScheduledApplications returns the applications scheduled on a fleet cluster.
+	ScheduledApplications(path string) ([]string, error)
+}
+
+// RolloutMaintainer performs fleet application rollout operations.
+type RolloutMaintainer interface {
+	// TogglePause pauses/resumes an application rollout.
+	TogglePause(path string, pause bool) error
+
+	// RollbackLKG rolls an application back to its last known good version.
+	RollbackLKG(path string) error
+}
+
 // Loggable represents resources with logs.
 type Loggable interface {
 	// TaiLogs streams resource logs.
@@ -105,6 +123,12 @@ type Describer interface {
 	ToYAML(path string, showManaged bool) (string, error)
 }
 
+// Detailer provides a custom detailed report of a resource.
+type Detailer interface {
+	// Detail returns a resource detailed report.
+	Detail(path string) (string, error)
+}
+
 // Scalable represents resources that can scale.
 type Scalable interface {
 	// Scale scales a resource up or down.
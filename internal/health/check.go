@@ -10,6 +10,9 @@ type Check struct {
 	Counts
 
 	GVR string
+
+	// Breakdown tracks resource counts by status.
+	Breakdown map[string]int64
 }
 
 // Checks represents a collection of health checks.
@@ -38,6 +41,14 @@ func (c *Check) Total(n int64) {
 	c.Counts[Corpus] = n
 }
 
+// Tag increments the count for a given status.
+func (c *Check) Tag(status string) {
+	if c.Breakdown == nil {
+		c.Breakdown = make(map[string]int64)
+	}
+	c.Breakdown[status]++
+}
+
 // Tally retrieves a given health metric.
 func (c *Check) Tally(l Level) int64 {
 	return c.Counts[l]
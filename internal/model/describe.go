@@ -25,6 +25,7 @@ type Describe struct {
 	lines       []string
 	refreshRate time.Duration
 	listeners   []ResourceViewerListener
+	detail      bool
 }
 
 // NewDescribe returns a new describe resource model.
@@ -36,6 +37,14 @@ func NewDescribe(gvr client.GVR, path string) *Describe {
 	}
 }
 
+// NewDetail returns a new resource model backed by a custom detailer.
+func NewDetail(gvr client.GVR, path string) *Describe {
+	d := NewDescribe(gvr, path)
+	d.detail = true
+
+	return d
+}
+
 // GetPath returns the active resource path.
 func (d *Describe) GetPath() string {
 	return d.path
@@ -183,6 +192,13 @@ func (d *Describe) describe(ctx context.Context, gvr client.GVR, path string) (s
 	if err != nil {
 		return "", err
 	}
+	if d.detail {
+		det, ok := meta.DAO.(dao.Detailer)
+		if !ok {
+			return "", fmt.Errorf("no detailer for %q", meta.DAO.GVR())
+		}
+		return det.Detail(path)
+	}
 	desc, ok := meta.DAO.(dao.Describer)
 	if !ok {
 		return "", fmt.Errorf("no describer for %q", meta.DAO.GVR())
@@ -0,0 +1,113 @@
+package model
+
+import (
+	"context"
+
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/health"
+	"github.com/derailed/k9s/internal/render"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// FleetPulseGVR represents the fleet pulse pseudo resource.
+const FleetPulseGVR = "fleetpulses"
+
+// A collection of fleet pulse checks.
+const (
+	FleetClusterHealthCheck     = "fleet/clusterhealth"
+	FleetClusterStateCheck      = "fleet/clusterstate"
+	FleetApplicationStateCheck  = "fleet/applicationstate"
+	FleetRolloutStatusCheck     = "fleet/rolloutstatus"
+	FleetManifestWorkStateCheck = "fleet/manifestworkstate"
+)
+
+// FleetHealthyStatuses tracks the statuses counted as healthy by each fleet pulse check.
+var FleetHealthyStatuses = map[string][]string{
+	FleetClusterHealthCheck:     {string(render.HealthyClusterHealth)},
+	FleetClusterStateCheck:      {string(render.ClusterStateReady)},
+	FleetApplicationStateCheck:  {string(render.ApplicationAvailable)},
+	FleetRolloutStatusCheck:     {string(render.CompletedRollout), string(render.RollingBackCompleted)},
+	FleetManifestWorkStateCheck: {string(render.ManifestAvailable)},
+}
+
+// FleetPulseHealth tracks fleet resources health.
+type FleetPulseHealth struct {
+	factory dao.Factory
+}
+
+// NewFleetPulseHealth returns a new instance.
+func NewFleetPulseHealth(f dao.Factory) *FleetPulseHealth {
+	return &FleetPulseHealth{
+		factory: f,
+	}
+}
+
+// List returns fleet clusters, applications and manifest works health.
+func (h *FleetPulseHealth) List(ctx context.Context, ns string) ([]runtime.Object, error) {
+	cc, err := dao.FetchFleetClusters(h.factory)
+	if err != nil {
+		return nil, err
+	}
+	aa, err := dao.FetchApplications(h.factory, ns)
+	if err != nil {
+		return nil, err
+	}
+	ww, err := dao.FetchManifestWorks(h.factory, ns)
+	if err != nil {
+		return nil, err
+	}
+
+	cc1, cc2 := clusterChecks(cc)
+	aa1, aa2 := applicationChecks(aa)
+
+	return []runtime.Object{cc1, cc2, aa1, aa2, manifestWorkCheck(ww)}, nil
+}
+
+func clusterChecks(cc []render.Cluster) (*health.Check, *health.Check) {
+	h, s := health.NewCheck(FleetClusterHealthCheck), health.NewCheck(FleetClusterStateCheck)
+	for _, cl := range cc {
+		tally(h, string(cl.Status.ClusterHealthStatus))
+		tally(s, string(cl.Status.RuntimeStatus.ClusterState))
+	}
+	h.Total(int64(len(cc)))
+	s.Total(int64(len(cc)))
+
+	return h, s
+}
+
+func applicationChecks(aa []render.Application) (*health.Check, *health.Check) {
+	s, r := health.NewCheck(FleetApplicationStateCheck), health.NewCheck(FleetRolloutStatusCheck)
+	for _, app := range aa {
+		tally(s, string(app.Status.ApplicationState))
+		tally(r, string(app.Status.RolloutStatus))
+	}
+	s.Total(int64(len(aa)))
+	r.Total(int64(len(aa)))
+
+	return s, r
+}
+
+func manifestWorkCheck(ww []render.ManifestWork) *health.Check {
+	c := health.NewCheck(FleetManifestWorkStateCheck)
+	for _, mw := range ww {
+		tally(c, string(mw.Status.ManifestState))
+	}
+	c.Total(int64(len(ww)))
+
+	return c
+}
+
+// tally counts a status as healthy if it matches one of the check healthy statuses.
+func tally(c *health.Check, status string) {
+	if status == "" {
+		status = render.UnknownValue
+	}
+	c.Tag(status)
+	for _, ok := range FleetHealthyStatuses[c.GVR] {
+		if status == ok {
+			c.Inc(health.S1)
+			return
+		}
+	}
+	c.Inc(health.S2)
+}
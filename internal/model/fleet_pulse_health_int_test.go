@@ -0,0 +1,56 @@
+package model
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/health"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClusterChecks(t *testing.T) {
+	cl := func(h render.ClusterHealthStatus, s render.ClusterState) render.Cluster {
+		var c render.Cluster
+		c.Status.ClusterHealthStatus, c.Status.RuntimeStatus.ClusterState = h, s
+		return c
+	}
+	cc := []render.Cluster{
+		cl(render.HealthyClusterHealth, render.ClusterStateReady),
+		cl(render.HealthyClusterHealth, render.ClusterStateDrain),
+		cl(render.FailedClusterHealth, render.ClusterStateFailed),
+		cl("", ""),
+	}
+
+	h, s := clusterChecks(cc)
+	assert.Equal(t, FleetClusterHealthCheck, h.GVR)
+	assert.Equal(t, int64(4), h.Tally(health.Corpus))
+	assert.Equal(t, int64(2), h.Tally(health.S1))
+	assert.Equal(t, int64(2), h.Tally(health.S2))
+	assert.Equal(t, map[string]int64{"Healthy": 2, "Failed": 1, render.UnknownValue: 1}, h.Breakdown)
+
+	assert.Equal(t, FleetClusterStateCheck, s.GVR)
+	assert.Equal(t, int64(1), s.Tally(health.S1))
+	assert.Equal(t, int64(3), s.Tally(health.S2))
+	assert.Equal(t, map[string]int64{"Ready": 1, "Drain": 1, "Failed": 1, render.UnknownValue: 1}, s.Breakdown)
+}
+
+func TestApplicationChecks(t *testing.T) {
+	app := func(s render.ApplicationState, r render.RolloutStatus) render.Application {
+		var a render.Application
+		a.Status.ApplicationState, a.Status.RolloutStatus = s, r
+		return a
+	}
+	aa := []render.Application{
+		app(render.ApplicationAvailable, render.CompletedRollout),
+		app(render.ApplicationDegraded, render.RollingBackCompleted),
+		app(render.ApplicationProgressing, render.InProgress),
+	}
+
+	s, r := applicationChecks(aa)
+	assert.Equal(t, int64(3), s.Tally(health.Corpus))
+	assert.Equal(t, int64(1), s.Tally(health.S1))
+	assert.Equal(t, int64(2), s.Tally(health.S2))
+	assert.Equal(t, int64(2), r.Tally(health.S1))
+	assert.Equal(t, int64(1), r.Tally(health.S2))
+	assert.Equal(t, map[string]int64{"Completed": 1, "RollingBackCompleted": 1, "InProgress": 1}, r.Breakdown)
+}
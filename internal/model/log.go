@@ -278,6 +278,25 @@ func (l *Log) Notify() {
 	}
 }
 
+// ToggleMute mutes or unmutes a given cluster logs. Returns true if the cluster is now muted.
+func (l *Log) ToggleMute(cluster string) bool {
+	muted := l.lines.ToggleMute(cluster)
+	l.fireLogCleared()
+	l.fireLogBuffChanged(0)
+
+	return muted
+}
+
+// IsMuted checks if a given cluster logs are muted.
+func (l *Log) IsMuted(cluster string) bool {
+	return l.lines.IsMuted(cluster)
+}
+
+// Clusters returns the clusters the logs came from.
+func (l *Log) Clusters() []string {
+	return l.lines.Clusters()
+}
+
 // ToggleAllContainers toggles to show all containers logs.
 func (l *Log) ToggleAllContainers(ctx context.Context) {
 	l.logOptions.ToggleAllContainers()
@@ -24,6 +24,12 @@ type PulseListener interface {
 	PulseFailed(error)
 }
 
+// HealthLister lists resources health checks.
+type HealthLister interface {
+	// List returns a collection of health checks.
+	List(context.Context, string) ([]runtime.Object, error)
+}
+
 // Pulse tracks multiple resources health.
 type Pulse struct {
 	gvr         string
@@ -31,7 +37,7 @@ type Pulse struct {
 	inUpdate    int32
 	listeners   []PulseListener
 	refreshRate time.Duration
-	health      *PulseHealth
+	health      HealthLister
 	data        health.Checks
 }
 
@@ -92,7 +98,11 @@ func (p *Pulse) list(ctx context.Context) ([]runtime.Object, error) {
 		return nil, fmt.Errorf("expected Factory in context but got %T", ctx.Value(internal.KeyFactory))
 	}
 	if p.health == nil {
-		p.health = NewPulseHealth(f)
+		if p.gvr == FleetPulseGVR {
+			p.health = NewFleetPulseHealth(f)
+		} else {
+			p.health = NewPulseHealth(f)
+		}
 	}
 	ctx = context.WithValue(ctx, internal.KeyFields, "")
 	ctx = context.WithValue(ctx, internal.KeyWithMetrics, false)
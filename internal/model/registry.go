@@ -152,8 +152,9 @@ var Registry = map[string]ResourceMeta{
 	},
 
 	"apis.clusterfleet.io/v1alpha1/applications": {
-		DAO:      &dao.Application{},
-		Renderer: &render.ApplicationRenderer{},
+		DAO:          &dao.Application{},
+		Renderer:     &render.ApplicationRenderer{},
+		TreeRenderer: &xray.Application{},
 	},
 	"apis.clusterfleet.io/v1alpha1/clusters": {
 		DAO:      &dao.FleetClusters{},
@@ -168,6 +169,80 @@ var Registry = map[string]ResourceMeta{
 		DAO:      &dao.ApplicationStatus{},
 		Renderer: &render.ApplicationStatusRenderer{},
 	},
+	"apis.clusterfleet.io/v1alpha1/fleetdisruptions": {
+		DAO:      &dao.FleetDisruption{},
+		Renderer: &render.FleetDisruptionRenderer{},
+	},
+	"apis.clusterfleet.io/v1alpha1/manifestworks": {
+		Renderer: &render.ManifestWorkRenderer{},
+	},
+	"workManifests": {
+		DAO:      &dao.WorkManifest{},
+		Renderer: &render.ClusterManifestRenderer{},
+	},
+	"apis.clusterfleet.io/v1alpha1/clusterdefinitions": {
+		DAO:          &dao.ClusterDefinition{},
+		Renderer:     &render.ClusterDefinitionRenderer{},
+		TreeRenderer: &xray.ClusterDefinition{},
+	},
+	"apis.clusterfleet.io/v1alpha1/clusterprovisioners": {
+		Renderer: &render.ClusterProvisionerRenderer{},
+	},
+	"apis.clusterfleet.io/v1alpha1/crossclusterservices": {
+		Renderer: &render.CrossClusterServiceRenderer{},
+	},
+	"apis.clusterfleet.io/v1alpha1/crossclustershards": {
+		Renderer: &render.CrossClusterShardRenderer{},
+	},
+	"serviceMembers": {
+		DAO:      &dao.ServiceMember{},
+		Renderer: &render.ServiceMemberRenderer{},
+	},
+	"apis.clusterfleet.io/v1alpha1/finegrainedsyncers": {
+		Renderer: &render.FineGrainedSyncerRenderer{},
+	},
+	"syncerMembers": {
+		DAO:      &dao.SyncerMember{},
+		Renderer: &render.SyncerMemberRenderer{},
+	},
+	"apis.clusterfleet.io/v1alpha1/clusterhealthpolicies": {
+		Renderer: &render.ClusterHealthRenderer{},
+	},
+	"apis.clusterfleet.io/v1alpha1/clusterhealthreports": {
+		Renderer: &render.ClusterHealthRenderer{},
+	},
+	"healthpolicies": {
+		DAO:      &dao.HealthPolicy{},
+		Renderer: &render.HealthPolicyRenderer{},
+	},
+	"rollouts": {
+		DAO:      &dao.Rollout{},
+		Renderer: &render.RolloutRenderer{},
+	},
+	"provisioningSteps": {
+		DAO:      &dao.ProvisioningStep{},
+		Renderer: &render.ProvisioningStepRenderer{},
+	},
+	"clusterManifests": {
+		DAO:      &dao.ClusterManifest{},
+		Renderer: &render.ClusterManifestRenderer{},
+	},
+	"memberPods": {
+		DAO:      &dao.MemberPod{},
+		Renderer: &render.MemberPod{},
+	},
+	"drifts": {
+		DAO:      &dao.Drift{},
+		Renderer: &render.DriftRenderer{},
+	},
+	"placements": {
+		DAO:      &dao.Placement{},
+		Renderer: &render.Placement{},
+	},
+	"notifications": {
+		DAO:      &dao.Notification{},
+		Renderer: &render.Notification{},
+	},
 
 	// CRDs...
 	"apiextensions.k8s.io/v1/customresourcedefinitions": {
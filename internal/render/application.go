@@ -7,7 +7,6 @@ import (
 	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/tcell/v2"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
-	"k8s.io/apimachinery/pkg/runtime"
 )
 
 // ApplicationRenderer renders a FalconFleet Application to screen.
@@ -43,6 +42,8 @@ func (ApplicationRenderer) Header(string) Header {
 		HeaderColumn{Name: "NAME"},
 		HeaderColumn{Name: "PROVISIONED"},
 		HeaderColumn{Name: "CLUSTERS"},
+		HeaderColumn{Name: "STATE", Wide: true},
+		HeaderColumn{Name: "ROLLOUT", Wide: true},
 		HeaderColumn{Name: "AGE", Time: true},
 	}
 }
@@ -54,8 +55,7 @@ func (c ApplicationRenderer) Render(o interface{}, ns string, r *Row) error {
 		return fmt.Errorf("Expected CustomResourceDefinition, but got %T", o)
 	}
 
-	var app Application
-	err := runtime.DefaultUnstructuredConverter.FromUnstructured(raw.Object, &app)
+	app, err := FleetObjects.Application(raw)
 	if err != nil {
 		return err
 	}
@@ -78,6 +78,8 @@ func (c ApplicationRenderer) Render(o interface{}, ns string, r *Row) error {
 		app.GetName(),
 		provisioned,
 		Truncate(join(clustersToShow, ","), 30),
+		NA(string(app.Status.ApplicationState)),
+		NA(string(app.Status.RolloutStatus)),
 		toAge(app.GetCreationTimestamp()),
 	}
 
@@ -1,10 +1,13 @@
 package render
 
 import (
-	"encoding/json"
+	"errors"
 	"fmt"
+	"strconv"
+	"strings"
 
 	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/tview"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
@@ -15,12 +18,19 @@ type ApplicationStatusRenderer struct {
 	Base
 }
 
+// ColorerFunc colors a resource row.
+func (ApplicationStatusRenderer) ColorerFunc() ColorerFunc {
+	return ManifestColorer
+}
+
 // Header returns a header rbw.
 func (ApplicationStatusRenderer) Header(string) Header {
 	return Header{
 		HeaderColumn{Name: "CLUSTER"},
 		HeaderColumn{Name: "READY"},
-		HeaderColumn{Name: "REPLICAS"},
+		HeaderColumn{Name: "MANIFESTS", Align: tview.AlignRight},
+		HeaderColumn{Name: "STATUS"},
+		HeaderColumn{Name: "VALID", Wide: true},
 	}
 }
 
@@ -31,47 +41,58 @@ func (c ApplicationStatusRenderer) Render(o interface{}, ns string, r *Row) erro
 		return fmt.Errorf("Expected ManifestRes, but got %T", o)
 	}
 
-	replicas := "-"
-	for _, status := range appStatus.Status.ManifestStatuses {
-		if status.Kind == "Deployment" {
-			deploymentStatus, _ := c.DeploymentStatusToMap(status)
-			repl, _, _ := unstructured.NestedFloat64(*deploymentStatus, "replicas")
-			avl, found, _ := unstructured.NestedFloat64(*deploymentStatus, "availableReplicas")
-
-			if found {
-				replicas = fmt.Sprint(avl) + "/" + fmt.Sprint(repl)
-			} else {
-				replicas = "0/" + fmt.Sprint(repl)
+	var (
+		healthy int
+		status  = ManifestAvailableStatus
+		errs    []string
+	)
+	for i := range appStatus.Status.ManifestStatuses {
+		ms := &appStatus.Status.ManifestStatuses[i]
+		h := InterpretManifestStatus(ms.Kind, appStatus.desired(i), ms)
+		switch {
+		case h.Err != nil:
+			errs = append(errs, ms.Kind+"/"+ms.Name+": "+h.Err.Error())
+		case isManifestPending(h.Status):
+			if status == ManifestAvailableStatus {
+				status = h.Status
 			}
+		default:
+			healthy++
 		}
 	}
+	var err error
+	if len(errs) > 0 {
+		status, err = "Degraded", errors.New(strings.Join(errs, "; "))
+	}
 
 	r.ID = client.FQN(client.ClusterScope, appStatus.Cluster)
 	r.Fields = Fields{
 		appStatus.Cluster,
 		appStatus.Ready,
-		replicas,
+		strconv.Itoa(healthy) + "/" + strconv.Itoa(len(appStatus.Status.ManifestStatuses)),
+		status,
+		asStatus(err),
 	}
 
 	return nil
 }
 
-func (c ApplicationStatusRenderer) DeploymentStatusToMap(
-	manifestStatus ManifestStatus,
-) (*map[string]interface{}, error) {
-	var status map[string]interface{}
-	err := json.Unmarshal(manifestStatus.Status.Raw, &status)
-	if err != nil {
-		return nil, err
-	}
-	return &status, nil
-}
-
 type ApplicationStatusRes struct {
 	Cluster string
 	Ready   string
 	Status  *ApplicationClusterStatus
 	Error   string
+
+	// Objects tracks the desired workload manifest for each manifest status, nil when not found.
+	Objects []*unstructured.Unstructured
+}
+
+func (c ApplicationStatusRes) desired(i int) *unstructured.Unstructured {
+	if i >= len(c.Objects) {
+		return nil
+	}
+
+	return c.Objects[i]
 }
 
 // GetObjectKind returns a schema object.
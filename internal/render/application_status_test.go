@@ -0,0 +1,31 @@
+package render_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestApplicationStatusRender(t *testing.T) {
+	lb := &unstructured.Unstructured{Object: map[string]interface{}{
+		"spec": map[string]interface{}{"type": "LoadBalancer"},
+	}}
+	job := &unstructured.Unstructured{Object: map[string]interface{}{
+		"spec": map[string]interface{}{"completions": int64(3)},
+	}}
+	status := render.ApplicationClusterStatus{
+		Cluster: "c1",
+		ManifestStatuses: []render.ManifestStatus{
+			{Name: "svc", Kind: "Service", Status: runtime.RawExtension{Raw: []byte(`{"loadBalancer":{}}`)}},
+			{Name: "job", Kind: "Job", Status: runtime.RawExtension{Raw: []byte(`{"succeeded":1}`)}},
+		},
+	}
+
+	var r render.Row
+	o := render.ApplicationStatusRes{Cluster: "c1", Ready: "Ready", Status: &status, Objects: []*unstructured.Unstructured{lb, job}}
+	assert.Nil(t, render.ApplicationStatusRenderer{}.Render(o, "", &r))
+	assert.Equal(t, render.Fields{"c1", "Ready", "0/2", "Pending", ""}, r.Fields)
+}
@@ -0,0 +1,189 @@
+package render
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/tview"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// ClusterProvisionerRenderer renders a fleet ClusterProvisioner to screen.
+type ClusterProvisionerRenderer struct {
+	Base
+}
+
+// Header returns a header row.
+func (ClusterProvisionerRenderer) Header(string) Header {
+	return Header{
+		HeaderColumn{Name: "NAME"},
+		HeaderColumn{Name: "PRIORITY", Align: tview.AlignRight},
+		HeaderColumn{Name: "DEFAULT-DEFINITION"},
+		HeaderColumn{Name: "PROPERTIES", Wide: true},
+		HeaderColumn{Name: "AGE", Time: true},
+	}
+}
+
+// Render renders a K8s resource to screen.
+func (ClusterProvisionerRenderer) Render(o interface{}, ns string, r *Row) error {
+	raw, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return fmt.Errorf("Expected ClusterProvisioner, but got %T", o)
+	}
+	var p ClusterProvisioner
+	err := runtime.DefaultUnstructuredConverter.FromUnstructured(raw.Object, &p)
+	if err != nil {
+		return err
+	}
+
+	r.ID = client.FQN(client.ClusterScope, p.GetName())
+	r.Fields = Fields{
+		p.GetName(),
+		strconv.Itoa(p.Spec.Priority),
+		NA(p.Spec.DefaultDefinitionName),
+		mapToStr(p.Spec.Properties),
+		toAge(p.GetCreationTimestamp()),
+	}
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+
+// ClusterDefinitionRenderer renders a fleet ClusterDefinition to screen.
+type ClusterDefinitionRenderer struct {
+	Base
+}
+
+// Header returns a header row.
+func (ClusterDefinitionRenderer) Header(string) Header {
+	return Header{
+		HeaderColumn{Name: "NAME"},
+		HeaderColumn{Name: "PRE-TASKS", Align: tview.AlignRight},
+		HeaderColumn{Name: "TASKS", Align: tview.AlignRight},
+		HeaderColumn{Name: "POST-TASKS", Align: tview.AlignRight},
+		HeaderColumn{Name: "REFS", Align: tview.AlignRight},
+		HeaderColumn{Name: "DANGLING", Align: tview.AlignRight},
+		HeaderColumn{Name: "PROPERTIES", Wide: true},
+		HeaderColumn{Name: "VALID", Wide: true},
+		HeaderColumn{Name: "AGE", Time: true},
+	}
+}
+
+// Render renders a K8s resource to screen.
+func (ClusterDefinitionRenderer) Render(o interface{}, ns string, r *Row) error {
+	raw, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return fmt.Errorf("Expected ClusterDefinition, but got %T", o)
+	}
+	var def ClusterDefinition
+	err := runtime.DefaultUnstructuredConverter.FromUnstructured(raw.Object, &def)
+	if err != nil {
+		return err
+	}
+
+	var refs, dangling int
+	for _, e := range TaskEdges(def.Spec) {
+		refs++
+		if e.Dangling {
+			dangling++
+		}
+	}
+
+	r.ID = client.FQN(client.ClusterScope, def.GetName())
+	r.Fields = Fields{
+		def.GetName(),
+		strconv.Itoa(len(def.Spec.PreTasks)),
+		strconv.Itoa(len(def.Spec.Tasks)),
+		strconv.Itoa(len(def.Spec.PostTasks)),
+		strconv.Itoa(refs),
+		strconv.Itoa(dangling),
+		mapToStr(def.Spec.Properties),
+		asStatus(ValidateTaskRefs(def.Spec)),
+		toAge(def.GetCreationTimestamp()),
+	}
+
+	return nil
+}
+
+// DefinitionPhase represents a cluster definition phase and its tasks.
+type DefinitionPhase struct {
+	Name  string
+	Tasks []ClusterDefinitionTask
+}
+
+// DefinitionPhases returns the cluster definition phases in execution order.
+func DefinitionPhases(spec ClusterDefinitionSpec) []DefinitionPhase {
+	return []DefinitionPhase{
+		{Name: PreTasksPhase, Tasks: spec.PreTasks},
+		{Name: TasksPhase, Tasks: spec.Tasks},
+		{Name: PostTasksPhase, Tasks: spec.PostTasks},
+	}
+}
+
+// TaskEdge represents a data flow from a task output to another task property.
+type TaskEdge struct {
+	Phase    string
+	Task     string
+	Property string
+	Ref      ClusterDefinitionTaskRef
+	// Dangling indicates the referenced task is not part of the definition.
+	Dangling bool
+	// Forward indicates the referenced task executes after the consuming task.
+	Forward bool
+}
+
+func (e TaskEdge) String() string {
+	return e.Property + " <- " + e.Ref.TaskName + "." + e.Ref.OutputProperty
+}
+
+// TaskEdges returns all value references of a cluster definition in execution order.
+func TaskEdges(spec ClusterDefinitionSpec) []TaskEdge {
+	order := make(map[string]int)
+	for _, ph := range DefinitionPhases(spec) {
+		for _, t := range ph.Tasks {
+			if _, ok := order[t.Name]; !ok {
+				order[t.Name] = len(order)
+			}
+		}
+	}
+
+	var ee []TaskEdge
+	for _, ph := range DefinitionPhases(spec) {
+		for _, t := range ph.Tasks {
+			kk := make([]string, 0, len(t.Properties))
+			for k, v := range t.Properties {
+				if v.ValueFrom.TaskName != "" {
+					kk = append(kk, k)
+				}
+			}
+			sort.Strings(kk)
+			for _, k := range kk {
+				e := TaskEdge{Phase: ph.Name, Task: t.Name, Property: k, Ref: t.Properties[k].ValueFrom}
+				idx, ok := order[e.Ref.TaskName]
+				e.Dangling = !ok
+				e.Forward = ok && idx >= order[t.Name]
+				ee = append(ee, e)
+			}
+		}
+	}
+
+	return ee
+}
+
+// ValidateTaskRefs checks all cluster definition value references point to a prior task.
+func ValidateTaskRefs(spec ClusterDefinitionSpec) error {
+	for _, e := range TaskEdges(spec) {
+		switch {
+		case e.Dangling:
+			return fmt.Errorf("task %q references unknown task %q", e.Task, e.Ref.TaskName)
+		case e.Forward:
+			return fmt.Errorf("task %q references task %q which has not run yet", e.Task, e.Ref.TaskName)
+		}
+	}
+
+	return nil
+}
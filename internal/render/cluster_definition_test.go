@@ -0,0 +1,87 @@
+package render_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClusterDefinitionRender(t *testing.T) {
+	var (
+		c render.ClusterDefinitionRenderer
+		r = render.NewRow(9)
+	)
+
+	assert.Nil(t, c.Render(load(t, "cldef"), "", &r))
+	assert.Equal(t, "-/aks-standard", r.ID)
+	assert.Equal(t, render.Fields{
+		"aks-standard",
+		"1",
+		"1",
+		"1",
+		"3",
+		"1",
+		"sku=standard",
+		`task "aks" references unknown task "identity"`,
+	}, r.Fields[:8])
+}
+
+func TestValidateTaskRefs(t *testing.T) {
+	ref := func(task, out string) render.ClusterDefinitionTaskPropertyValue {
+		return render.ClusterDefinitionTaskPropertyValue{
+			ValueFrom: render.ClusterDefinitionTaskRef{TaskName: task, OutputProperty: out},
+		}
+	}
+	task := func(n string, pp map[string]render.ClusterDefinitionTaskPropertyValue) render.ClusterDefinitionTask {
+		return render.ClusterDefinitionTask{Name: n, TaskType: "t", Properties: pp}
+	}
+
+	uu := map[string]struct {
+		spec render.ClusterDefinitionSpec
+		err  error
+	}{
+		"empty": {},
+		"cool": {
+			spec: render.ClusterDefinitionSpec{
+				PreTasks: []render.ClusterDefinitionTask{task("t1", nil)},
+				Tasks: []render.ClusterDefinitionTask{
+					task("t2", map[string]render.ClusterDefinitionTaskPropertyValue{"a": ref("t1", "o")}),
+				},
+			},
+		},
+		"dangling": {
+			spec: render.ClusterDefinitionSpec{
+				Tasks: []render.ClusterDefinitionTask{
+					task("t1", map[string]render.ClusterDefinitionTaskPropertyValue{"a": ref("t0", "o")}),
+				},
+			},
+			err: errors.New(`task "t1" references unknown task "t0"`),
+		},
+		"forward": {
+			spec: render.ClusterDefinitionSpec{
+				Tasks: []render.ClusterDefinitionTask{
+					task("t1", map[string]render.ClusterDefinitionTaskPropertyValue{"a": ref("t2", "o")}),
+				},
+				PostTasks: []render.ClusterDefinitionTask{task("t2", nil)},
+			},
+			err: errors.New(`task "t1" references task "t2" which has not run yet`),
+		},
+		"self": {
+			spec: render.ClusterDefinitionSpec{
+				Tasks: []render.ClusterDefinitionTask{
+					task("t1", map[string]render.ClusterDefinitionTaskPropertyValue{"a": ref("t1", "o")}),
+				},
+			},
+			err: errors.New(`task "t1" references task "t1" which has not run yet`),
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.err, render.ValidateTaskRefs(u.spec))
+		})
+	}
+}
@@ -0,0 +1,216 @@
+package render
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/tcell/v2"
+	"github.com/derailed/tview"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// ClusterHealthRenderer renders a fleet ClusterHealthPolicy or ClusterHealthReport to screen.
+type ClusterHealthRenderer struct {
+	Base
+}
+
+// ColorerFunc colors a resource row.
+func (ClusterHealthRenderer) ColorerFunc() ColorerFunc {
+	return PolicyStatusColorer
+}
+
+// Header returns a header row.
+func (ClusterHealthRenderer) Header(string) Header {
+	return Header{
+		HeaderColumn{Name: "NAME"},
+		HeaderColumn{Name: "STATUS"},
+		HeaderColumn{Name: "POLICIES", Align: tview.AlignRight},
+		HeaderColumn{Name: "HEALTHY", Align: tview.AlignRight},
+		HeaderColumn{Name: "PARTIAL", Align: tview.AlignRight},
+		HeaderColumn{Name: "UNHEALTHY", Align: tview.AlignRight},
+		HeaderColumn{Name: "N/A", Align: tview.AlignRight},
+		HeaderColumn{Name: "OS"},
+		HeaderColumn{Name: "AGE", Time: true},
+	}
+}
+
+// Render renders a K8s resource to screen.
+func (c ClusterHealthRenderer) Render(o interface{}, ns string, r *Row) error {
+	raw, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return fmt.Errorf("Expected ClusterHealthReport, but got %T", o)
+	}
+
+	// Policies and reports share the same shape.
+	var chr ClusterHealthReport
+	err := runtime.DefaultUnstructuredConverter.FromUnstructured(raw.Object, &chr)
+	if err != nil {
+		return err
+	}
+
+	counts := make(map[PolicyStatus]int, 4)
+	for _, s := range chr.Status.ClusterHealthPoliciesStatus {
+		counts[s.HealthPolicyStatus.PolicyStatus]++
+	}
+
+	r.ID = client.FQN(client.ClusterScope, chr.GetName())
+	r.Fields = Fields{
+		chr.GetName(),
+		string(worstPolicyStatus(counts)),
+		strconv.Itoa(len(chr.Spec.Policies)),
+		strconv.Itoa(counts[PolicyStatusHealthy]),
+		strconv.Itoa(counts[PolicyStatusPartialHealthy]),
+		strconv.Itoa(counts[PolicyStatusUnhealthy]),
+		strconv.Itoa(counts[PolicyStatusNotApplicable]),
+		osPresent(chr.Status.OsNodesPresentMap),
+		toAge(chr.GetCreationTimestamp()),
+	}
+
+	return nil
+}
+
+func worstPolicyStatus(counts map[PolicyStatus]int) PolicyStatus {
+	for _, s := range []PolicyStatus{PolicyStatusUnhealthy, PolicyStatusPartialHealthy, PolicyStatusHealthy} {
+		if counts[s] > 0 {
+			return s
+		}
+	}
+
+	return PolicyStatusNotApplicable
+}
+
+func osPresent(m map[OperatingSystem]bool) string {
+	oo := make([]string, 0, len(m))
+	for os, ok := range m {
+		if ok {
+			oo = append(oo, string(os))
+		}
+	}
+	sort.Strings(oo)
+
+	return naStrings(oo)
+}
+
+// PolicyStatusColorer colors a row based on its health policy status.
+func PolicyStatusColorer(ns string, h Header, re RowEvent) tcell.Color {
+	c := DefaultColorer(ns, h, re)
+	statusCol := h.IndexOf("STATUS", true)
+	if statusCol == -1 {
+		return c
+	}
+	switch PolicyStatus(strings.TrimSpace(re.Row.Fields[statusCol])) {
+	case PolicyStatusUnhealthy:
+		return ErrColor
+	case PolicyStatusPartialHealthy:
+		return PendingColor
+	case PolicyStatusNotApplicable:
+		return CompletedColor
+	default:
+		return c
+	}
+}
+
+// ----------------------------------------------------------------------------
+
+// HealthPolicyRenderer renders a single health policy to screen.
+type HealthPolicyRenderer struct {
+	Base
+}
+
+// ColorerFunc colors a resource row.
+func (HealthPolicyRenderer) ColorerFunc() ColorerFunc {
+	return PolicyStatusColorer
+}
+
+// Header returns a header row.
+func (HealthPolicyRenderer) Header(string) Header {
+	return Header{
+		HeaderColumn{Name: "POLICY"},
+		HeaderColumn{Name: "STATUS"},
+		HeaderColumn{Name: "IMPACT"},
+		HeaderColumn{Name: "CAPABILITIES"},
+		HeaderColumn{Name: "OS"},
+		HeaderColumn{Name: "SELECTOR", Wide: true},
+		HeaderColumn{Name: "MIN-AVAILABLE", Wide: true},
+		HeaderColumn{Name: "MESSAGE"},
+		HeaderColumn{Name: "LAST-TRANSITION", Time: true},
+	}
+}
+
+// Render renders a health policy to screen.
+func (c HealthPolicyRenderer) Render(o interface{}, ns string, r *Row) error {
+	p, ok := o.(HealthPolicyRes)
+	if !ok {
+		return fmt.Errorf("Expected HealthPolicyRes, but got %T", o)
+	}
+
+	status, message, transition := PolicyStatusNotApplicable, "", ""
+	if p.Status != nil {
+		status, message, transition = p.Status.PolicyStatus, p.Status.Message, p.Status.LastTransitionTime
+	}
+	cc := make([]string, 0, len(p.Spec.ComponentCapabilities))
+	for _, c := range p.Spec.ComponentCapabilities {
+		cc = append(cc, string(c))
+	}
+	oo := make([]string, 0, len(p.Spec.OsApplicable))
+	for _, os := range p.Spec.OsApplicable {
+		oo = append(oo, string(os))
+	}
+
+	r.ID = p.Spec.PolicyName
+	r.Fields = Fields{
+		p.Spec.PolicyName,
+		string(status),
+		NA(string(p.Spec.ClusterHealthImpact)),
+		naStrings(cc),
+		naStrings(oo),
+		policySelector(p.Spec),
+		p.Spec.MinAvailable.String() + "/" + p.Spec.MinPartialAvailable.String(),
+		NA(message),
+		toAgeHuman(transition),
+	}
+
+	return nil
+}
+
+func policySelector(p PolicySpec) string {
+	var sel string
+	if p.SelectKind != "" {
+		sel = p.SelectKind
+	}
+	if p.SelectNamespace != "" {
+		sel += "@" + p.SelectNamespace
+	}
+	if p.SelectName != "" {
+		return NA(sel + ":" + p.SelectName)
+	}
+	if len(p.MatchLabels) > 0 {
+		sel += "[" + mapToStr(p.MatchLabels) + "]"
+	}
+	if p.SelectApplicationName != "" {
+		sel += " app=" + p.SelectApplicationName
+	}
+
+	return NA(strings.TrimSpace(sel))
+}
+
+// HealthPolicyRes represents a health policy spec along with its reported status.
+type HealthPolicyRes struct {
+	Spec   PolicySpec
+	Status *HealthPolicyStatus
+}
+
+// GetObjectKind returns a schema object.
+func (HealthPolicyRes) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a container copy.
+func (h HealthPolicyRes) DeepCopyObject() runtime.Object {
+	return h
+}
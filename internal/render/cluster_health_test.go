@@ -0,0 +1,68 @@
+package render_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+func TestClusterHealthRender(t *testing.T) {
+	c := render.ClusterHealthRenderer{}
+	r := render.NewRow(9)
+
+	assert.Nil(t, c.Render(load(t, "chr"), "", &r))
+	assert.Equal(t, "-/fred", r.ID)
+	assert.Equal(t, render.Fields{"fred", "PartialHealthy", "3", "1", "1", "0", "1", "linux"}, r.Fields[:8])
+}
+
+func TestHealthPolicyRender(t *testing.T) {
+	uu := map[string]struct {
+		res render.HealthPolicyRes
+		e   render.Fields
+	}{
+		"by-name": {
+			res: render.HealthPolicyRes{
+				Spec: render.PolicySpec{
+					PolicyName:            "coredns",
+					SelectKind:            "Deployment",
+					SelectNamespace:       "kube-system",
+					SelectName:            "coredns",
+					MinAvailable:          intstr.FromInt(2),
+					MinPartialAvailable:   intstr.FromInt(1),
+					ClusterHealthImpact:   render.ClusterHealthImpactHigh,
+					ComponentCapabilities: []render.ComponentCapability{render.ComponentCapabilityDiscovery},
+				},
+				Status: &render.HealthPolicyStatus{
+					PolicyName:   "coredns",
+					PolicyStatus: render.PolicyStatusUnhealthy,
+					Message:      "0/2 available",
+				},
+			},
+			e: render.Fields{"coredns", "Unhealthy", "High", "Discovery", "n/a", "Deployment@kube-system:coredns", "2/1", "0/2 available", "<unknown>"},
+		},
+		"no-status": {
+			res: render.HealthPolicyRes{
+				Spec: render.PolicySpec{
+					PolicyName:   "logs",
+					SelectKind:   "DaemonSet",
+					MatchLabels:  map[string]string{"app": "fluentd"},
+					OsApplicable: []render.OperatingSystem{render.OperatingSystemWindows},
+				},
+			},
+			e: render.Fields{"logs", "NotApplicable", "n/a", "n/a", "windows", "DaemonSet[app=fluentd]", "0/0", "n/a", "<unknown>"},
+		},
+	}
+
+	var c render.HealthPolicyRenderer
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			var r render.Row
+			assert.Nil(t, c.Render(u.res, "", &r))
+			assert.Equal(t, u.res.Spec.PolicyName, r.ID)
+			assert.Equal(t, u.e, r.Fields)
+		})
+	}
+}
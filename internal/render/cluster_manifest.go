@@ -0,0 +1,94 @@
+package render
+
+import (
+	"fmt"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/tview"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// ClusterManifestRenderer renders an application manifest status on a given fleet cluster.
+type ClusterManifestRenderer struct {
+	Base
+}
+
+// ColorerFunc colors a resource row.
+func (ClusterManifestRenderer) ColorerFunc() ColorerFunc {
+	return ManifestColorer
+}
+
+// Header returns a header row.
+func (ClusterManifestRenderer) Header(string) Header {
+	return Header{
+		HeaderColumn{Name: "NAMESPACE"},
+		HeaderColumn{Name: "NAME"},
+		HeaderColumn{Name: "KIND"},
+		HeaderColumn{Name: "REPLICAS", Align: tview.AlignRight},
+		HeaderColumn{Name: "READY", Align: tview.AlignRight},
+		HeaderColumn{Name: "STATUS"},
+		HeaderColumn{Name: "CONDITIONS"},
+		HeaderColumn{Name: "VALID", Wide: true},
+	}
+}
+
+// Render renders a manifest status to screen.
+func (c ClusterManifestRenderer) Render(o interface{}, ns string, r *Row) error {
+	manifest, ok := o.(ClusterManifestRes)
+	if !ok {
+		return fmt.Errorf("Expected ClusterManifestRes, but got %T", o)
+	}
+
+	h := InterpretManifestStatus(manifest.Kind, manifest.Object, manifest.Status)
+	if manifest.Err != nil {
+		h = ManifestHealth{Ready: NAValue, Status: ManifestInvalidStatus, Err: manifest.Err}
+	}
+	var cc []string
+	if manifest.Status != nil {
+		if status, err := manifestStatusToMap(*manifest.Status); err == nil {
+			for _, c := range conditions(status) {
+				cc = append(cc, c.kind+"="+c.status)
+			}
+		}
+	}
+
+	r.ID = client.FQN(manifest.Namespace, manifest.Name+"_"+manifest.Kind)
+	r.Fields = Fields{
+		manifest.Namespace,
+		manifest.Name,
+		manifest.Kind,
+		manifest.Replicas,
+		h.Ready,
+		h.Status,
+		naStrings(cc),
+		asStatus(h.Err),
+	}
+
+	return nil
+}
+
+// ClusterManifestRes represents an application manifest status on a fleet cluster.
+type ClusterManifestRes struct {
+	Cluster   string
+	Name      string
+	Namespace string
+	Kind      string
+	Replicas  string
+	Object    *unstructured.Unstructured
+	Status    *ManifestStatus
+
+	// Err tracks the manifest decoding error if any.
+	Err error
+}
+
+// GetObjectKind returns a schema object.
+func (c ClusterManifestRes) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a container copy.
+func (c ClusterManifestRes) DeepCopyObject() runtime.Object {
+	return c
+}
@@ -0,0 +1,59 @@
+package render_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestClusterManifestRender(t *testing.T) {
+	uu := map[string]struct {
+		status string
+		err    error
+		e      render.Fields
+	}{
+		"ready": {
+			status: `{"replicas":3,"updatedReplicas":3,"availableReplicas":3,"conditions":[{"type":"Available","status":"True"}]}`,
+			e:      render.Fields{"fred", "blee", "Deployment", "3", "3/3", "Available", "Available=True", ""},
+		},
+		"not-ready": {
+			status: `{"replicas":3,"availableReplicas":1,"conditions":[{"type":"Available","status":"False"},{"type":"Progressing","status":"True"}]}`,
+			e:      render.Fields{"fred", "blee", "Deployment", "3", "1/3", "Unavailable", "Available=False,Progressing=True", "condition Available is False"},
+		},
+		"no-status": {
+			e: render.Fields{"fred", "blee", "Deployment", "3", "n/a", "<unknown>", "n/a", ""},
+		},
+		"invalid": {
+			err: errors.New("failed to convert manifest.Raw"),
+			e:   render.Fields{"fred", "blee", "Deployment", "3", "n/a", "Invalid", "n/a", "failed to convert manifest.Raw"},
+		},
+	}
+
+	var c render.ClusterManifestRenderer
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			res := render.ClusterManifestRes{
+				Cluster:   "c1",
+				Name:      "blee",
+				Namespace: "fred",
+				Kind:      "Deployment",
+				Replicas:  "3",
+				Status: &render.ManifestStatus{
+					Name:      "blee",
+					Namespace: "fred",
+					Kind:      "Deployment",
+					Status:    runtime.RawExtension{Raw: []byte(u.status)},
+				},
+				Err: u.err,
+			}
+			var r render.Row
+			assert.Nil(t, c.Render(res, "", &r))
+			assert.Equal(t, "fred/blee_Deployment", r.ID)
+			assert.Equal(t, u.e, r.Fields)
+		})
+	}
+}
@@ -0,0 +1,280 @@
+package render
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/tcell/v2"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// A collection of cross cluster service types and shard resolvers.
+const (
+	LoadBalancedService = "Load-Balanced"
+	ShardedService      = "Sharded"
+	ByLabelResolver     = "byLabel"
+	ByOrdinalResolver   = "byOrdinal"
+)
+
+// CrossClusterServiceRenderer renders a fleet CrossClusterService to screen.
+type CrossClusterServiceRenderer struct {
+	Base
+}
+
+// Header returns a header row.
+func (CrossClusterServiceRenderer) Header(string) Header {
+	return Header{
+		HeaderColumn{Name: "NAMESPACE"},
+		HeaderColumn{Name: "NAME"},
+		HeaderColumn{Name: "TYPE"},
+		HeaderColumn{Name: "RESOLVER"},
+		HeaderColumn{Name: "CLUSTER-SELECTOR"},
+		HeaderColumn{Name: "POD-SELECTOR"},
+		HeaderColumn{Name: "VALID", Wide: true},
+		HeaderColumn{Name: "AGE", Time: true},
+	}
+}
+
+// Render renders a K8s resource to screen.
+func (c CrossClusterServiceRenderer) Render(o interface{}, ns string, r *Row) error {
+	raw, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return fmt.Errorf("Expected CrossClusterService, but got %T", o)
+	}
+	var ccs CrossClusterService
+	err := runtime.DefaultUnstructuredConverter.FromUnstructured(raw.Object, &ccs)
+	if err != nil {
+		return err
+	}
+
+	resolver := NAValue
+	if ServiceType(ccs.Spec) == ShardedService {
+		resolver = ShardResolverType(ccs.Spec.ShardResolver)
+		if ccs.Spec.ShardResolver.LabelName != "" {
+			resolver += ":" + ccs.Spec.ShardResolver.LabelName
+		}
+	}
+
+	r.ID = client.MetaFQN(ccs.ObjectMeta)
+	r.Fields = Fields{
+		ccs.Namespace,
+		ccs.Name,
+		ServiceType(ccs.Spec),
+		resolver,
+		selectorsToStr(ccs.Spec.ClusterSelector),
+		selectorsToStr(ccs.Spec.PodSelector),
+		asStatus(ValidateCrossClusterService(ccs.Spec)),
+		toAge(ccs.GetCreationTimestamp()),
+	}
+
+	return nil
+}
+
+// ServiceType returns a cross cluster service type.
+func ServiceType(spec CrossClusterServiceSpec) string {
+	if spec.Type == "" {
+		return LoadBalancedService
+	}
+
+	return spec.Type
+}
+
+// ShardResolverType returns a shard resolver type.
+func ShardResolverType(r ShardResolver) string {
+	if r.ResolverType == "" {
+		return ByLabelResolver
+	}
+
+	return r.ResolverType
+}
+
+// ValidateCrossClusterService checks a cross cluster service spec is consistent.
+func ValidateCrossClusterService(spec CrossClusterServiceSpec) error {
+	switch ServiceType(spec) {
+	case LoadBalancedService:
+		if spec.ShardResolver != (ShardResolver{}) {
+			return fmt.Errorf("shard resolver must be empty for a %s service", LoadBalancedService)
+		}
+	case ShardedService:
+		switch ShardResolverType(spec.ShardResolver) {
+		case ByLabelResolver:
+			if spec.ShardResolver.LabelName == "" {
+				return fmt.Errorf("%s resolver requires a label name", ByLabelResolver)
+			}
+		case ByOrdinalResolver:
+			if spec.ShardResolver.LabelName != "" {
+				return fmt.Errorf("%s resolver must not specify a label name", ByOrdinalResolver)
+			}
+		default:
+			return fmt.Errorf("unknown shard resolver %q", spec.ShardResolver.ResolverType)
+		}
+	default:
+		return fmt.Errorf("unknown service type %q", spec.Type)
+	}
+
+	return nil
+}
+
+// ResolveShard returns the shard name a pod resolves to.
+func ResolveShard(r ShardResolver, pod string, labels map[string]string) (string, error) {
+	switch ShardResolverType(r) {
+	case ByLabelResolver:
+		if s, ok := labels[r.LabelName]; ok && s != "" {
+			return s, nil
+		}
+		return "", fmt.Errorf("missing shard label %q", r.LabelName)
+	case ByOrdinalResolver:
+		idx := strings.LastIndex(pod, "-")
+		if idx == -1 {
+			return "", fmt.Errorf("no ordinal in pod name %q", pod)
+		}
+		if _, err := strconv.Atoi(pod[idx+1:]); err != nil {
+			return "", fmt.Errorf("no ordinal in pod name %q", pod)
+		}
+		return pod[idx+1:], nil
+	default:
+		return "", fmt.Errorf("unknown shard resolver %q", r.ResolverType)
+	}
+}
+
+func selectorsToStr(ss []map[string]string) string {
+	if len(ss) == 0 {
+		return NAValue
+	}
+	ll := make([]string, 0, len(ss))
+	for _, s := range ss {
+		ll = append(ll, mapToStr(s))
+	}
+
+	return strings.Join(ll, " | ")
+}
+
+// ----------------------------------------------------------------------------
+
+// CrossClusterShardRenderer renders a fleet CrossClusterShard to screen.
+type CrossClusterShardRenderer struct {
+	Base
+}
+
+// Header returns a header row.
+func (CrossClusterShardRenderer) Header(string) Header {
+	return Header{
+		HeaderColumn{Name: "NAMESPACE"},
+		HeaderColumn{Name: "NAME"},
+		HeaderColumn{Name: "SERVICE"},
+		HeaderColumn{Name: "LABELS", Wide: true},
+		HeaderColumn{Name: "AGE", Time: true},
+	}
+}
+
+// Render renders a K8s resource to screen.
+func (c CrossClusterShardRenderer) Render(o interface{}, ns string, r *Row) error {
+	raw, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return fmt.Errorf("Expected CrossClusterShard, but got %T", o)
+	}
+	var shard CrossClusterShard
+	err := runtime.DefaultUnstructuredConverter.FromUnstructured(raw.Object, &shard)
+	if err != nil {
+		return err
+	}
+
+	r.ID = client.MetaFQN(shard.ObjectMeta)
+	r.Fields = Fields{
+		shard.Namespace,
+		shard.Name,
+		NA(shard.Spec.ServiceName),
+		mapToStr(shard.Labels),
+		toAge(shard.GetCreationTimestamp()),
+	}
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+
+// A collection of cross cluster service member kinds.
+const (
+	ShardMember   = "Shard"
+	ClusterMember = "Cluster"
+	PodMember     = "Pod"
+)
+
+// ServiceMemberRenderer renders a cross cluster service shards, clusters and pods to screen.
+type ServiceMemberRenderer struct {
+	Base
+}
+
+// ColorerFunc colors a resource row.
+func (ServiceMemberRenderer) ColorerFunc() ColorerFunc {
+	return func(ns string, h Header, re RowEvent) tcell.Color {
+		c := DefaultColorer(ns, h, re)
+		kindCol := h.IndexOf("KIND", true)
+		if c == ErrColor || kindCol == -1 {
+			return c
+		}
+		switch strings.TrimSpace(re.Row.Fields[kindCol]) {
+		case ShardMember:
+			return HighlightColor
+		case ClusterMember:
+			return CompletedColor
+		default:
+			return c
+		}
+	}
+}
+
+// Header returns a header row.
+func (ServiceMemberRenderer) Header(string) Header {
+	return Header{
+		HeaderColumn{Name: "KIND"},
+		HeaderColumn{Name: "NAME"},
+		HeaderColumn{Name: "CLUSTER"},
+		HeaderColumn{Name: "SHARD"},
+		HeaderColumn{Name: "STATUS"},
+		HeaderColumn{Name: "LABELS", Wide: true},
+		HeaderColumn{Name: "VALID", Wide: true},
+	}
+}
+
+// Render renders a cross cluster service member to screen.
+func (ServiceMemberRenderer) Render(o interface{}, ns string, r *Row) error {
+	m, ok := o.(ServiceMemberRes)
+	if !ok {
+		return fmt.Errorf("Expected ServiceMemberRes, but got %T", o)
+	}
+
+	r.ID = m.Kind + ":" + client.FQN(m.Cluster, m.Name)
+	r.Fields = Fields{
+		m.Kind,
+		m.Name,
+		NA(m.Cluster),
+		NA(m.Shard),
+		NA(m.Status),
+		mapToStr(m.Labels),
+		asStatus(m.Err),
+	}
+
+	return nil
+}
+
+// ServiceMemberRes represents a cross cluster service shard, selected cluster or matching member cluster pod.
+type ServiceMemberRes struct {
+	Kind, Name, Cluster, Shard, Status string
+	Labels                             map[string]string
+	Err                                error
+}
+
+// GetObjectKind returns a schema object.
+func (ServiceMemberRes) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a container copy.
+func (s ServiceMemberRes) DeepCopyObject() runtime.Object {
+	return s
+}
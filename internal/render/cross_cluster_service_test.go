@@ -0,0 +1,99 @@
+package render_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResolveShard(t *testing.T) {
+	uu := map[string]struct {
+		resolver render.ShardResolver
+		pod      string
+		labels   map[string]string
+		e        string
+		err      error
+	}{
+		"by-label": {
+			resolver: render.ShardResolver{ResolverType: render.ByLabelResolver, LabelName: "shard"},
+			pod:      "store-abc",
+			labels:   map[string]string{"shard": "s1"},
+			e:        "s1",
+		},
+		"default-by-label": {
+			resolver: render.ShardResolver{LabelName: "shard"},
+			pod:      "store-abc",
+			labels:   map[string]string{"shard": "s2"},
+			e:        "s2",
+		},
+		"missing-label": {
+			resolver: render.ShardResolver{LabelName: "shard"},
+			pod:      "store-abc",
+			err:      errors.New(`missing shard label "shard"`),
+		},
+		"by-ordinal": {
+			resolver: render.ShardResolver{ResolverType: render.ByOrdinalResolver},
+			pod:      "store-3",
+			e:        "3",
+		},
+		"no-ordinal": {
+			resolver: render.ShardResolver{ResolverType: render.ByOrdinalResolver},
+			pod:      "store-abc",
+			err:      errors.New(`no ordinal in pod name "store-abc"`),
+		},
+		"unknown": {
+			resolver: render.ShardResolver{ResolverType: "byMagic"},
+			pod:      "store-1",
+			err:      errors.New(`unknown shard resolver "byMagic"`),
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			s, err := render.ResolveShard(u.resolver, u.pod, u.labels)
+			assert.Equal(t, u.err, err)
+			assert.Equal(t, u.e, s)
+		})
+	}
+}
+
+func TestValidateCrossClusterService(t *testing.T) {
+	uu := map[string]struct {
+		spec render.CrossClusterServiceSpec
+		err  error
+	}{
+		"load-balanced": {},
+		"load-balanced-resolver": {
+			spec: render.CrossClusterServiceSpec{ShardResolver: render.ShardResolver{LabelName: "shard"}},
+			err:  errors.New("shard resolver must be empty for a Load-Balanced service"),
+		},
+		"by-label": {
+			spec: render.CrossClusterServiceSpec{Type: render.ShardedService, ShardResolver: render.ShardResolver{LabelName: "shard"}},
+		},
+		"by-label-no-label": {
+			spec: render.CrossClusterServiceSpec{Type: render.ShardedService},
+			err:  errors.New("byLabel resolver requires a label name"),
+		},
+		"by-ordinal-label": {
+			spec: render.CrossClusterServiceSpec{
+				Type:          render.ShardedService,
+				ShardResolver: render.ShardResolver{ResolverType: render.ByOrdinalResolver, LabelName: "shard"},
+			},
+			err: errors.New("byOrdinal resolver must not specify a label name"),
+		},
+		"unknown-type": {
+			spec: render.CrossClusterServiceSpec{Type: "Blee"},
+			err:  errors.New(`unknown service type "Blee"`),
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.err, render.ValidateCrossClusterService(u.spec))
+		})
+	}
+}
@@ -0,0 +1,287 @@
+package render
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/tcell/v2"
+	"github.com/derailed/tview"
+	"github.com/pmezard/go-difflib/difflib"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// A collection of manifest drift statuses.
+const (
+	DriftedStatus = "Drifted"
+	MissingStatus = "Missing"
+	InSyncStatus  = "InSync"
+)
+
+// serverFields tracks metadata fields populated by the api server.
+var serverFields = []string{
+	"managedFields",
+	"resourceVersion",
+	"uid",
+	"generation",
+	"creationTimestamp",
+	"deletionTimestamp",
+	"deletionGracePeriodSeconds",
+	"selfLink",
+	"ownerReferences",
+}
+
+// DriftRenderer renders manifest drifts on member clusters to screen.
+type DriftRenderer struct {
+	Base
+}
+
+// ColorerFunc colors a resource row.
+func (DriftRenderer) ColorerFunc() ColorerFunc {
+	return func(ns string, h Header, re RowEvent) tcell.Color {
+		statusCol := h.IndexOf("STATUS", true)
+		if statusCol == -1 {
+			return DefaultColorer(ns, h, re)
+		}
+		switch strings.TrimSpace(re.Row.Fields[statusCol]) {
+		case MissingStatus, UnreachableStatus:
+			return ErrColor
+		case DriftedStatus:
+			return PendingColor
+		default:
+			return DefaultColorer(ns, h, re)
+		}
+	}
+}
+
+// Header returns a header row.
+func (DriftRenderer) Header(string) Header {
+	return Header{
+		HeaderColumn{Name: "CLUSTER"},
+		HeaderColumn{Name: "NAMESPACE"},
+		HeaderColumn{Name: "NAME"},
+		HeaderColumn{Name: "KIND"},
+		HeaderColumn{Name: "STATUS"},
+		HeaderColumn{Name: "FIELDS", Align: tview.AlignRight},
+		HeaderColumn{Name: "PATHS", Wide: true},
+		HeaderColumn{Name: "VALID", Wide: true},
+	}
+}
+
+// Render renders a K8s resource to screen.
+func (DriftRenderer) Render(o interface{}, ns string, r *Row) error {
+	res, ok := o.(*DriftRes)
+	if !ok {
+		return fmt.Errorf("Expected DriftRes, but got %T", o)
+	}
+
+	r.ID = res.ID()
+	r.Fields = Fields{
+		res.Cluster,
+		res.Namespace,
+		res.Name,
+		res.Kind,
+		res.Status,
+		strconv.Itoa(len(res.Paths)),
+		strings.Join(res.Paths, ","),
+		asStatus(res.Err),
+	}
+
+	return nil
+}
+
+// DriftRes represents a manifest drift on a member cluster.
+type DriftRes struct {
+	Cluster, Namespace, Name, Kind string
+	Status                         string
+	Paths                          []string
+	Err                            error
+}
+
+// ID returns the drift identifier ie cluster/namespace/name_kind.
+func (d *DriftRes) ID() string {
+	if d.Name == "" {
+		return d.Cluster
+	}
+	return d.Cluster + "/" + client.FQN(d.Namespace, d.Name+"_"+d.Kind)
+}
+
+// GetObjectKind returns a schema object.
+func (d *DriftRes) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a container copy.
+func (d *DriftRes) DeepCopyObject() runtime.Object {
+	return d
+}
+
+// PruneServerFields returns a copy of an object without its status and server populated metadata.
+func PruneServerFields(o *unstructured.Unstructured) *unstructured.Unstructured {
+	c := o.DeepCopy()
+	delete(c.Object, "status")
+	if m, ok := c.Object["metadata"].(map[string]interface{}); ok {
+		for _, f := range serverFields {
+			delete(m, f)
+		}
+		if aa, ok := m["annotations"].(map[string]interface{}); ok {
+			delete(aa, "kubectl.kubernetes.io/last-applied-configuration")
+			delete(aa, "deployment.kubernetes.io/revision")
+			if len(aa) == 0 {
+				delete(m, "annotations")
+			}
+		}
+	}
+
+	return c
+}
+
+// ProjectFields returns the live fields matching the fields set on the desired object.
+// Fields defaulted by the api server are thus left out.
+func ProjectFields(desired, live interface{}) interface{} {
+	switch d := desired.(type) {
+	case map[string]interface{}:
+		l, ok := live.(map[string]interface{})
+		if !ok {
+			return live
+		}
+		out := make(map[string]interface{}, len(d))
+		for k, v := range d {
+			if lv, ok := l[k]; ok {
+				out[k] = ProjectFields(v, lv)
+			}
+		}
+		return out
+	case []interface{}:
+		l, ok := live.([]interface{})
+		if !ok || len(l) != len(d) {
+			return live
+		}
+		out := make([]interface{}, len(d))
+		for i := range d {
+			out[i] = ProjectFields(d[i], l[i])
+		}
+		return out
+	default:
+		return live
+	}
+}
+
+// ManifestDrift returns the sorted paths of the desired fields that differ on the live object.
+func ManifestDrift(desired, live *unstructured.Unstructured) []string {
+	var pp []string
+	driftPaths("", PruneServerFields(desired).Object, PruneServerFields(live).Object, &pp)
+	sort.Strings(pp)
+
+	return pp
+}
+
+func driftPaths(path string, desired, live interface{}, pp *[]string) {
+	switch d := desired.(type) {
+	case map[string]interface{}:
+		l, ok := live.(map[string]interface{})
+		if !ok {
+			*pp = append(*pp, path)
+			return
+		}
+		for k, v := range d {
+			lv, ok := l[k]
+			if !ok {
+				if !isEmptyValue(v) {
+					*pp = append(*pp, joinPath(path, k))
+				}
+				continue
+			}
+			driftPaths(joinPath(path, k), v, lv, pp)
+		}
+	case []interface{}:
+		l, ok := live.([]interface{})
+		if !ok || len(l) != len(d) {
+			*pp = append(*pp, path)
+			return
+		}
+		for i := range d {
+			driftPaths(fmt.Sprintf("%s[%d]", path, i), d[i], l[i], pp)
+		}
+	default:
+		if !sameScalar(desired, live) {
+			*pp = append(*pp, path)
+		}
+	}
+}
+
+func joinPath(path, k string) string {
+	if path == "" {
+		return k
+	}
+	return path + "." + k
+}
+
+// sameScalar compares scalars regardless of their numeric representation.
+func sameScalar(a, b interface{}) bool {
+	if reflect.DeepEqual(a, b) {
+		return true
+	}
+	return fmt.Sprintf("%v", a) == fmt.Sprintf("%v", b)
+}
+
+func isEmptyValue(v interface{}) bool {
+	switch t := v.(type) {
+	case nil:
+		return true
+	case map[string]interface{}:
+		return len(t) == 0
+	case []interface{}:
+		return len(t) == 0
+	default:
+		return false
+	}
+}
+
+// DiffLine represents a side by side diff line.
+type DiffLine struct {
+	Left, Right string
+	Changed     bool
+}
+
+// SideBySide aligns two texts line by line, flagging the lines that differ.
+func SideBySide(left, right string) []DiffLine {
+	ll, rr := difflib.SplitLines(left), difflib.SplitLines(right)
+	trim := func(s string) string { return strings.TrimSuffix(s, "\n") }
+
+	m := difflib.NewMatcher(ll, rr)
+	out := make([]DiffLine, 0, len(ll))
+	for _, op := range m.GetOpCodes() {
+		if op.Tag == 'e' {
+			for i := op.I1; i < op.I2; i++ {
+				out = append(out, DiffLine{Left: trim(ll[i]), Right: trim(rr[op.J1+i-op.I1])})
+			}
+			continue
+		}
+		n := op.I2 - op.I1
+		if op.J2-op.J1 > n {
+			n = op.J2 - op.J1
+		}
+		for i := 0; i < n; i++ {
+			var l DiffLine
+			l.Changed = true
+			if op.I1+i < op.I2 {
+				l.Left = trim(ll[op.I1+i])
+			}
+			if op.J1+i < op.J2 {
+				l.Right = trim(rr[op.J1+i])
+			}
+			out = append(out, l)
+		}
+	}
+	if len(out) > 0 && out[len(out)-1] == (DiffLine{}) {
+		out = out[:len(out)-1]
+	}
+
+	return out
+}
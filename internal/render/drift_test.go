@@ -0,0 +1,103 @@
+package render_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestManifestDrift(t *testing.T) {
+	desired := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "apps/v1",
+		"kind":       "Deployment",
+		"metadata": map[string]interface{}{
+			"name":      "nginx",
+			"namespace": "default",
+		},
+		"spec": map[string]interface{}{
+			"replicas": int64(3),
+			"template": map[string]interface{}{
+				"spec": map[string]interface{}{
+					"containers": []interface{}{
+						map[string]interface{}{"name": "nginx", "image": "nginx:1.25"},
+					},
+				},
+			},
+		},
+	}}
+
+	uu := map[string]struct {
+		mutate func(*unstructured.Unstructured)
+		e      []string
+	}{
+		"same": {
+			mutate: func(*unstructured.Unstructured) {},
+		},
+		"server-fields": {
+			mutate: func(u *unstructured.Unstructured) {
+				u.SetResourceVersion("42")
+				u.SetManagedFields(nil)
+				u.Object["metadata"].(map[string]interface{})["managedFields"] = []interface{}{map[string]interface{}{"manager": "kubectl"}}
+				u.Object["status"] = map[string]interface{}{"replicas": int64(1)}
+			},
+		},
+		"defaulted": {
+			mutate: func(u *unstructured.Unstructured) {
+				_ = unstructured.SetNestedField(u.Object, "RollingUpdate", "spec", "strategy", "type")
+			},
+		},
+		"replicas": {
+			mutate: func(u *unstructured.Unstructured) {
+				_ = unstructured.SetNestedField(u.Object, int64(1), "spec", "replicas")
+			},
+			e: []string{"spec.replicas"},
+		},
+		"image": {
+			mutate: func(u *unstructured.Unstructured) {
+				_ = unstructured.SetNestedSlice(u.Object, []interface{}{
+					map[string]interface{}{"name": "nginx", "image": "nginx:1.24"},
+				}, "spec", "template", "spec", "containers")
+			},
+			e: []string{"spec.template.spec.containers[0].image"},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			live := desired.DeepCopy()
+			u.mutate(live)
+			assert.Equal(t, u.e, render.ManifestDrift(desired, live))
+		})
+	}
+}
+
+func TestSideBySide(t *testing.T) {
+	ll := render.SideBySide("a: 1\nb: 2\nc: 3\n", "a: 1\nb: 5\nc: 3\n")
+
+	assert.Equal(t, []render.DiffLine{
+		{Left: "a: 1", Right: "a: 1"},
+		{Left: "b: 2", Right: "b: 5", Changed: true},
+		{Left: "c: 3", Right: "c: 3"},
+	}, ll)
+}
+
+func TestDriftRender(t *testing.T) {
+	res := render.DriftRes{
+		Cluster:   "c1",
+		Namespace: "default",
+		Name:      "nginx",
+		Kind:      "Deployment",
+		Status:    render.MissingStatus,
+		Err:       errors.New("not found"),
+	}
+
+	var d render.DriftRenderer
+	r := render.NewRow(8)
+	assert.Nil(t, d.Render(&res, "", &r))
+	assert.Equal(t, "c1/default/nginx_Deployment", r.ID)
+	assert.Equal(t, render.Fields{"c1", "default", "nginx", "Deployment", "Missing", "0", "", "not found"}, r.Fields)
+}
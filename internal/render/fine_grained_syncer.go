@@ -0,0 +1,172 @@
+package render
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/tcell/v2"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// A collection of fine grained syncer member sync states.
+const (
+	SyncedState     = "Synced"
+	OutOfSyncState  = "OutOfSync"
+	MissingState    = "Missing"
+	UnresolvedState = "Unresolved"
+)
+
+// FineGrainedSyncerRenderer renders a fleet FineGrainedSyncer to screen.
+type FineGrainedSyncerRenderer struct {
+	Base
+}
+
+// Header returns a header row.
+func (FineGrainedSyncerRenderer) Header(string) Header {
+	return Header{
+		HeaderColumn{Name: "NAMESPACE"},
+		HeaderColumn{Name: "NAME"},
+		HeaderColumn{Name: "SELECTOR"},
+		HeaderColumn{Name: "FOLLOW"},
+		HeaderColumn{Name: "LABELS", Wide: true},
+		HeaderColumn{Name: "AGE", Time: true},
+	}
+}
+
+// Render renders a K8s resource to screen.
+func (FineGrainedSyncerRenderer) Render(o interface{}, ns string, r *Row) error {
+	raw, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return fmt.Errorf("Expected FineGrainedSyncer, but got %T", o)
+	}
+	var fgs FineGrainedSyncer
+	err := runtime.DefaultUnstructuredConverter.FromUnstructured(raw.Object, &fgs)
+	if err != nil {
+		return err
+	}
+
+	r.ID = client.MetaFQN(fgs.ObjectMeta)
+	r.Fields = Fields{
+		fgs.Namespace,
+		fgs.Name,
+		NA(mapToStr(fgs.Spec.Selector)),
+		NA(strings.Join(fgs.Spec.Follow, ",")),
+		mapToStr(fgs.Labels),
+		toAge(fgs.GetCreationTimestamp()),
+	}
+
+	return nil
+}
+
+// SyncState returns a followed fleet resource sync state. A resource whose latest generation
+// was not observed yet is out of sync, otherwise its conditions are interpreted if any.
+func SyncState(o *unstructured.Unstructured) (string, error) {
+	status, _, _ := unstructured.NestedMap(o.Object, "status")
+	if observed := nestedInt(status, "observedGeneration"); observed > 0 && observed < o.GetGeneration() {
+		return OutOfSyncState, fmt.Errorf("generation %d not observed yet (%d)", o.GetGeneration(), observed)
+	}
+	if len(conditions(status)) == 0 {
+		if status == nil {
+			return UnknownValue, nil
+		}
+		return SyncedState, nil
+	}
+	h := conditionsHealth(o, status)
+
+	return h.Status, h.Err
+}
+
+// ApplicationSyncState returns a selected application sync state.
+func ApplicationSyncState(app *Application) (string, error) {
+	if app.Status.ObservedGeneration > 0 && app.Status.ObservedGeneration < app.Generation {
+		return OutOfSyncState, fmt.Errorf("generation %d not observed yet (%d)", app.Generation, app.Status.ObservedGeneration)
+	}
+	switch {
+	case app.Status.ApplicationState == ApplicationDegraded:
+		return string(app.Status.ApplicationState), fmt.Errorf("application is degraded")
+	case app.Status.RolloutStatus == RollingBack:
+		return string(app.Status.RolloutStatus), fmt.Errorf("application is rolling back")
+	case app.Status.ApplicationState != "":
+		return string(app.Status.ApplicationState), nil
+	default:
+		return UnknownValue, nil
+	}
+}
+
+// ----------------------------------------------------------------------------
+
+// SyncerApplication designates the applications selected by a fine grained syncer.
+const SyncerApplication = "Application"
+
+// SyncerMemberRenderer renders a fine grained syncer selected applications and followed resources to screen.
+type SyncerMemberRenderer struct {
+	Base
+}
+
+// ColorerFunc colors a resource row.
+func (SyncerMemberRenderer) ColorerFunc() ColorerFunc {
+	return func(ns string, h Header, re RowEvent) tcell.Color {
+		c := DefaultColorer(ns, h, re)
+		kindCol := h.IndexOf("KIND", true)
+		if c == ErrColor || kindCol == -1 {
+			return c
+		}
+		if strings.TrimSpace(re.Row.Fields[kindCol]) == SyncerApplication {
+			return HighlightColor
+		}
+
+		return c
+	}
+}
+
+// Header returns a header row.
+func (SyncerMemberRenderer) Header(string) Header {
+	return Header{
+		HeaderColumn{Name: "KIND"},
+		HeaderColumn{Name: "NAME"},
+		HeaderColumn{Name: "FOLLOW"},
+		HeaderColumn{Name: "STATE"},
+		HeaderColumn{Name: "LABELS", Wide: true},
+		HeaderColumn{Name: "VALID", Wide: true},
+	}
+}
+
+// Render renders a fine grained syncer member to screen.
+func (SyncerMemberRenderer) Render(o interface{}, ns string, r *Row) error {
+	m, ok := o.(SyncerMemberRes)
+	if !ok {
+		return fmt.Errorf("Expected SyncerMemberRes, but got %T", o)
+	}
+
+	r.ID = m.Kind + ":" + m.Follow + ":" + m.Name
+	r.Fields = Fields{
+		m.Kind,
+		NA(m.Name),
+		NA(m.Follow),
+		NA(m.State),
+		mapToStr(m.Labels),
+		asStatus(m.Err),
+	}
+
+	return nil
+}
+
+// SyncerMemberRes represents an application selected by a fine grained syncer or a followed resource instance.
+type SyncerMemberRes struct {
+	Kind, Name, Follow, State string
+	Labels                    map[string]string
+	Err                       error
+}
+
+// GetObjectKind returns a schema object.
+func (SyncerMemberRes) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a container copy.
+func (s SyncerMemberRes) DeepCopyObject() runtime.Object {
+	return s
+}
@@ -0,0 +1,90 @@
+package render_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestFineGrainedSyncerRender(t *testing.T) {
+	o := unstructured.Unstructured{Object: map[string]interface{}{
+		"kind":     "FineGrainedSyncer",
+		"metadata": map[string]interface{}{"name": "fgs", "namespace": "default"},
+		"spec": map[string]interface{}{
+			"selector": map[string]interface{}{"app": "store"},
+			"follow":   []interface{}{"crossclusterservices", "fleetdisruptions"},
+		},
+	}}
+
+	var r render.Row
+	assert.Nil(t, render.FineGrainedSyncerRenderer{}.Render(&o, "", &r))
+	assert.Equal(t, "default/fgs", r.ID)
+	assert.Equal(t, render.Fields{"default", "fgs", "app=store", "crossclusterservices,fleetdisruptions", ""}, r.Fields[:5])
+}
+
+func TestSyncState(t *testing.T) {
+	uu := map[string]struct {
+		status map[string]interface{}
+		gen    int64
+		e      string
+		err    error
+	}{
+		"no-status": {
+			e: render.UnknownValue,
+		},
+		"synced": {
+			status: map[string]interface{}{"observedGeneration": int64(2)},
+			gen:    2,
+			e:      render.SyncedState,
+		},
+		"out-of-sync": {
+			status: map[string]interface{}{"observedGeneration": int64(1)},
+			gen:    2,
+			e:      render.OutOfSyncState,
+			err:    errors.New("generation 2 not observed yet (1)"),
+		},
+		"ready": {
+			status: map[string]interface{}{"conditions": []interface{}{
+				map[string]interface{}{"type": "Ready", "status": "True"},
+			}},
+			e: "Ready",
+		},
+		"not-ready": {
+			status: map[string]interface{}{"conditions": []interface{}{
+				map[string]interface{}{"type": "Ready", "status": "False", "reason": "Pending"},
+			}},
+			e:   "NotReady",
+			err: errors.New("condition Ready is False: Pending"),
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			o := unstructured.Unstructured{Object: map[string]interface{}{
+				"metadata": map[string]interface{}{"name": "fred", "generation": u.gen},
+			}}
+			if u.status != nil {
+				o.Object["status"] = u.status
+			}
+			s, err := render.SyncState(&o)
+			assert.Equal(t, u.err, err)
+			assert.Equal(t, u.e, s)
+		})
+	}
+}
+
+func TestApplicationSyncState(t *testing.T) {
+	var app render.Application
+	s, err := render.ApplicationSyncState(&app)
+	assert.Nil(t, err)
+	assert.Equal(t, render.UnknownValue, s)
+
+	app.Status.ApplicationState, app.Status.RolloutStatus = render.ApplicationApplied, render.RollingBack
+	s, err = render.ApplicationSyncState(&app)
+	assert.Equal(t, errors.New("application is rolling back"), err)
+	assert.Equal(t, string(render.RollingBack), s)
+}
@@ -0,0 +1,222 @@
+package render
+
+import (
+	"sync"
+	"time"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	lru "k8s.io/apimachinery/pkg/util/cache"
+	"k8s.io/client-go/tools/cache"
+)
+
+const (
+	// fleetCacheSize tracks the maximum number of cached fleet objects.
+	fleetCacheSize = 2_000
+
+	// fleetCacheExpiry tracks how long a decoded fleet object is retained.
+	fleetCacheExpiry = 10 * time.Minute
+)
+
+// FleetObjects tracks the typed fleet objects shared by renderers and DAOs.
+var FleetObjects = NewFleetCache(fleetCacheSize)
+
+// DecodedManifest represents a decoded application workload manifest.
+type DecodedManifest struct {
+	Object *unstructured.Unstructured
+	Err    error
+}
+
+type fleetEntry struct {
+	rv        string
+	obj       interface{}
+	manifests []DecodedManifest
+}
+
+// FleetCache caches typed fleet objects keyed by UID and resourceVersion.
+// An object is only decoded again once its resourceVersion changes. The cache
+// is bounded and least recently used or expired entries are evicted, so objects
+// that are never seen deleted by an informer do not linger. Cached objects are
+// shared and must be treated as read only.
+type FleetCache struct {
+	items *lru.LRUExpireCache
+	size  int
+	mx    sync.RWMutex
+}
+
+// NewFleetCache returns a new fleet objects cache holding at most size objects.
+func NewFleetCache(size int) *FleetCache {
+	return &FleetCache{items: lru.NewLRUExpireCache(size), size: size}
+}
+
+// Application returns a typed fleet application.
+// The application is shared by all callers and must not be mutated.
+func (c *FleetCache) Application(u *unstructured.Unstructured) (*Application, error) {
+	if o, ok := c.lookup(u).(*Application); ok {
+		return o, nil
+	}
+	var app Application
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, &app); err != nil {
+		return nil, err
+	}
+	c.store(u, &app)
+
+	return &app, nil
+}
+
+// Cluster returns a typed fleet cluster.
+// The cluster is shared by all callers and must not be mutated.
+func (c *FleetCache) Cluster(u *unstructured.Unstructured) (*Cluster, error) {
+	if o, ok := c.lookup(u).(*Cluster); ok {
+		return o, nil
+	}
+	var cl Cluster
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, &cl); err != nil {
+		return nil, err
+	}
+	c.store(u, &cl)
+
+	return &cl, nil
+}
+
+// ManifestWork returns a typed fleet manifest work.
+// The manifest work is shared by all callers and must not be mutated.
+func (c *FleetCache) ManifestWork(u *unstructured.Unstructured) (*ManifestWork, error) {
+	if o, ok := c.lookup(u).(*ManifestWork); ok {
+		return o, nil
+	}
+	var mw ManifestWork
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, &mw); err != nil {
+		return nil, err
+	}
+	c.store(u, &mw)
+
+	return &mw, nil
+}
+
+// Manifests returns an application decoded workload manifests in workload order.
+// The manifests are shared by all callers and must not be mutated.
+func (c *FleetCache) Manifests(app *Application) []DecodedManifest {
+	mm := make([]Manifest, 0, len(app.Spec.Workload))
+	for _, w := range app.Spec.Workload {
+		mm = append(mm, w.ManifestItem)
+	}
+
+	return c.manifests(app.UID, app.ResourceVersion, mm)
+}
+
+// WorkManifests returns a manifest work decoded manifests in workload order.
+// The manifests are shared by all callers and must not be mutated.
+func (c *FleetCache) WorkManifests(mw *ManifestWork) []DecodedManifest {
+	return c.manifests(mw.UID, mw.ResourceVersion, mw.Spec.Workload.Manifests)
+}
+
+// manifests decodes raw manifests once per cached object resourceVersion.
+func (c *FleetCache) manifests(uid types.UID, rv string, raw []Manifest) []DecodedManifest {
+	c.mx.RLock()
+	e, ok := c.get(uid)
+	c.mx.RUnlock()
+	if ok && e.rv == rv && e.manifests != nil {
+		return e.manifests
+	}
+
+	mm := make([]DecodedManifest, 0, len(raw))
+	for _, m := range raw {
+		o, err := ManifestToUnstructed(m)
+		mm = append(mm, DecodedManifest{Object: o, Err: err})
+	}
+	if !ok || e.rv != rv {
+		return mm
+	}
+
+	c.mx.Lock()
+	defer c.mx.Unlock()
+	if e, ok := c.get(uid); ok && e.rv == rv {
+		e.manifests = mm
+		c.items.Add(uid, e, fleetCacheExpiry)
+	}
+
+	return mm
+}
+
+// Warm decodes an informer object ahead of its first use.
+func (c *FleetCache) Warm(o interface{}) {
+	u, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return
+	}
+	switch u.GetKind() {
+	case "Application":
+		_, _ = c.Application(u)
+	case "Cluster":
+		_, _ = c.Cluster(u)
+	case "ManifestWork":
+		_, _ = c.ManifestWork(u)
+	}
+}
+
+// Evict removes an informer object from the cache.
+func (c *FleetCache) Evict(o interface{}) {
+	if t, ok := o.(cache.DeletedFinalStateUnknown); ok {
+		o = t.Obj
+	}
+	u, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return
+	}
+
+	c.mx.Lock()
+	defer c.mx.Unlock()
+	c.items.Remove(u.GetUID())
+}
+
+// Clear evicts all cached objects.
+func (c *FleetCache) Clear() {
+	c.mx.Lock()
+	defer c.mx.Unlock()
+	c.items = lru.NewLRUExpireCache(c.size)
+}
+
+// Len returns the number of cached objects.
+func (c *FleetCache) Len() int {
+	c.mx.RLock()
+	defer c.mx.RUnlock()
+
+	return len(c.items.Keys())
+}
+
+// lookup returns a cached object matching an unstructured object UID and resourceVersion.
+func (c *FleetCache) lookup(u *unstructured.Unstructured) interface{} {
+	c.mx.RLock()
+	defer c.mx.RUnlock()
+	e, ok := c.get(u.GetUID())
+	if !ok || e.rv != u.GetResourceVersion() {
+		return nil
+	}
+
+	return e.obj
+}
+
+// store caches a decoded object unless it lacks a UID or resourceVersion.
+func (c *FleetCache) store(u *unstructured.Unstructured, o interface{}) {
+	uid, rv := u.GetUID(), u.GetResourceVersion()
+	if uid == "" || rv == "" {
+		return
+	}
+
+	c.mx.Lock()
+	defer c.mx.Unlock()
+	c.items.Add(uid, fleetEntry{rv: rv, obj: o}, fleetCacheExpiry)
+}
+
+// get returns a cached entry given an object UID.
+func (c *FleetCache) get(uid types.UID) (fleetEntry, bool) {
+	o, ok := c.items.Get(uid)
+	if !ok {
+		return fleetEntry{}, false
+	}
+	e, ok := o.(fleetEntry)
+
+	return e, ok
+}
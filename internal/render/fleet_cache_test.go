@@ -0,0 +1,103 @@
+package render_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/cache"
+)
+
+func TestFleetCacheApplication(t *testing.T) {
+	c := render.NewFleetCache(10)
+	u := fleetApp("1", "nginx")
+
+	a1, err := c.Application(u)
+	assert.Nil(t, err)
+	a2, err := c.Application(u.DeepCopy())
+	assert.Nil(t, err)
+	assert.Same(t, a1, a2)
+	assert.Equal(t, 1, c.Len())
+
+	u.SetResourceVersion("2")
+	a3, err := c.Application(u)
+	assert.Nil(t, err)
+	assert.NotSame(t, a1, a3)
+	assert.Equal(t, 1, c.Len())
+
+	c.Evict(cache.DeletedFinalStateUnknown{Obj: u})
+	assert.Equal(t, 0, c.Len())
+
+	_, err = c.Application(fleetApp("1", "blee"))
+	assert.Nil(t, err)
+	assert.Equal(t, 1, c.Len())
+	c.Clear()
+	assert.Equal(t, 0, c.Len())
+}
+
+func TestFleetCacheUncached(t *testing.T) {
+	c := render.NewFleetCache(10)
+	u := fleetApp("", "nginx")
+
+	a1, err := c.Application(u)
+	assert.Nil(t, err)
+	a2, err := c.Application(u)
+	assert.Nil(t, err)
+	assert.NotSame(t, a1, a2)
+	assert.Equal(t, 0, c.Len())
+}
+
+func TestFleetCacheManifests(t *testing.T) {
+	c := render.NewFleetCache(10)
+	u := fleetApp("1", "nginx")
+	c.Warm(u)
+
+	app, err := c.Application(u)
+	assert.Nil(t, err)
+	mm := c.Manifests(app)
+	assert.Equal(t, 1, len(mm))
+	assert.Nil(t, mm[0].Err)
+	assert.Equal(t, "nginx", mm[0].Object.GetName())
+	assert.Same(t, mm[0].Object, c.Manifests(app)[0].Object)
+}
+
+func TestFleetCacheBounded(t *testing.T) {
+	c := render.NewFleetCache(2)
+	for _, uid := range []types.UID{"u1", "u2", "u3"} {
+		u := fleetApp("1", "nginx")
+		u.SetUID(uid)
+		_, err := c.Application(u)
+		assert.Nil(t, err)
+	}
+	assert.Equal(t, 2, c.Len())
+}
+
+func fleetApp(rv, manifest string) *unstructured.Unstructured {
+	u := unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "apis.clusterfleet.io/v1alpha1",
+		"kind":       "Application",
+		"metadata": map[string]interface{}{
+			"name":      "app",
+			"namespace": "fleet",
+		},
+		"spec": map[string]interface{}{
+			"workload": []interface{}{
+				map[string]interface{}{
+					"manifest": map[string]interface{}{
+						"apiVersion": "apps/v1",
+						"kind":       "Deployment",
+						"metadata":   map[string]interface{}{"name": manifest, "namespace": "default"},
+					},
+				},
+			},
+		},
+	}}
+	if rv != "" {
+		u.SetUID(types.UID("uid-1"))
+		u.SetResourceVersion(rv)
+	}
+
+	return &u
+}
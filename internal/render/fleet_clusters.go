@@ -7,7 +7,6 @@ import (
 	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/tcell/v2"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
-	"k8s.io/apimachinery/pkg/runtime"
 )
 
 // CustomResourceDefinition renders a K8s CustomResourceDefinition to screen.
@@ -48,6 +47,7 @@ func (ClusterRenderer) Header(string) Header {
 		HeaderColumn{Name: "USAGE"},
 		HeaderColumn{Name: "PROVISIONED"},
 		HeaderColumn{Name: "HEALTH"},
+		HeaderColumn{Name: "STATUS", Wide: true},
 		HeaderColumn{Name: "AGE", Time: true},
 	}
 }
@@ -59,8 +59,7 @@ func (c ClusterRenderer) Render(o interface{}, ns string, r *Row) error {
 		return fmt.Errorf("Expected CustomResourceDefinition, but got %T", o)
 	}
 
-	var cl Cluster
-	err := runtime.DefaultUnstructuredConverter.FromUnstructured(raw.Object, &cl)
+	cl, err := FleetObjects.Cluster(raw)
 	if err != nil {
 		return err
 	}
@@ -80,6 +79,7 @@ func (c ClusterRenderer) Render(o interface{}, ns string, r *Row) error {
 		"general",
 		provisioned,
 		string(cl.Status.RuntimeStatus.ClusterState),
+		NA(string(cl.Status.ClusterHealthStatus)),
 		toAge(cl.GetCreationTimestamp()),
 	}
 
@@ -0,0 +1,166 @@
+package render
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/tcell/v2"
+	"github.com/derailed/tview"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+// A collection of fleet disruption budget states.
+const (
+	BudgetAvailable = "Available"
+	BudgetExhausted = "Exhausted"
+	BudgetViolated  = "Violated"
+)
+
+// FleetDisruptionRenderer renders a fleet disruption budget to screen.
+type FleetDisruptionRenderer struct {
+	Base
+}
+
+// ColorerFunc colors a resource row.
+func (FleetDisruptionRenderer) ColorerFunc() ColorerFunc {
+	return func(ns string, h Header, re RowEvent) tcell.Color {
+		c := DefaultColorer(ns, h, re)
+		statusCol := h.IndexOf("STATUS", true)
+		if statusCol == -1 {
+			return c
+		}
+		switch strings.TrimSpace(re.Row.Fields[statusCol]) {
+		case BudgetViolated:
+			return ErrColor
+		case BudgetExhausted:
+			return PendingColor
+		default:
+			return c
+		}
+	}
+}
+
+// Header returns a header row.
+func (FleetDisruptionRenderer) Header(string) Header {
+	return Header{
+		HeaderColumn{Name: "NAMESPACE"},
+		HeaderColumn{Name: "NAME"},
+		HeaderColumn{Name: "SELECTOR"},
+		HeaderColumn{Name: "MAX-UNAVAILABLE", Align: tview.AlignRight},
+		HeaderColumn{Name: "ALLOWED", Align: tview.AlignRight},
+		HeaderColumn{Name: "UNAVAILABLE", Align: tview.AlignRight},
+		HeaderColumn{Name: "CLUSTERS", Align: tview.AlignRight},
+		HeaderColumn{Name: "STATUS"},
+		HeaderColumn{Name: "UNAVAILABLE-CLUSTERS", Wide: true},
+		HeaderColumn{Name: "VALID", Wide: true},
+		HeaderColumn{Name: "AGE", Time: true},
+	}
+}
+
+// Render renders a fleet disruption budget to screen.
+func (f FleetDisruptionRenderer) Render(o interface{}, ns string, r *Row) error {
+	res, ok := o.(*FleetDisruptionWithClusters)
+	if !ok {
+		return fmt.Errorf("Expected FleetDisruptionWithClusters, but got %T", o)
+	}
+	var fd FleetDisruption
+	err := runtime.DefaultUnstructuredConverter.FromUnstructured(res.Raw.Object, &fd)
+	if err != nil {
+		return err
+	}
+
+	var down []string
+	for i := range res.Clusters {
+		if !IsClusterAvailable(&res.Clusters[i]) {
+			down = append(down, res.Clusters[i].Name)
+		}
+	}
+	status := NAValue
+	allowed, err := AllowedUnavailable(fd.Spec.MaxUnavailable, len(res.Clusters))
+	if err == nil {
+		status = budgetStatus(allowed, len(down))
+		if status != BudgetAvailable {
+			err = fmt.Errorf("budget %s: %d/%d clusters unavailable", strings.ToLower(status), len(down), allowed)
+		}
+	}
+
+	r.ID = client.MetaFQN(fd.ObjectMeta)
+	r.Fields = Fields{
+		fd.Namespace,
+		fd.Name,
+		NA(mapToStr(fd.Spec.ClusterSelector)),
+		NA(fd.Spec.MaxUnavailable),
+		strconv.Itoa(allowed),
+		strconv.Itoa(len(down)),
+		strconv.Itoa(len(res.Clusters)),
+		status,
+		naStrings(down),
+		asStatus(err),
+		toAge(fd.GetCreationTimestamp()),
+	}
+
+	return nil
+}
+
+func budgetStatus(allowed, unavailable int) string {
+	switch {
+	case unavailable > allowed:
+		return BudgetViolated
+	case unavailable == allowed:
+		return BudgetExhausted
+	default:
+		return BudgetAvailable
+	}
+}
+
+// AllowedUnavailable returns the number of clusters allowed to be unavailable.
+// Percentages are rounded up, i.e. 10% of 101 clusters allows 11 unavailable clusters.
+func AllowedUnavailable(maxUnavailable string, total int) (int, error) {
+	if maxUnavailable == "" {
+		return 0, fmt.Errorf("no max unavailable specified")
+	}
+	v := intstr.Parse(maxUnavailable)
+	if v.Type == intstr.String && !strings.HasSuffix(v.StrVal, "%") {
+		return 0, fmt.Errorf("invalid max unavailable %q", maxUnavailable)
+	}
+	n, err := intstr.GetScaledValueFromIntOrPercent(&v, total, true)
+	if err != nil {
+		return 0, err
+	}
+	if n < 0 {
+		return 0, fmt.Errorf("invalid max unavailable %q", maxUnavailable)
+	}
+
+	return n, nil
+}
+
+// IsClusterAvailable checks if a fleet cluster is both healthy and active.
+func IsClusterAvailable(cl *Cluster) bool {
+	activity := cl.Status.ClusterActivityStatus
+	if activity == "" {
+		activity = ActiveClusterActivity
+	}
+
+	return cl.Status.ClusterHealthStatus == HealthyClusterHealth && activity == ActiveClusterActivity
+}
+
+// FleetDisruptionWithClusters represents a fleet disruption budget and its matching clusters.
+type FleetDisruptionWithClusters struct {
+	Raw      *unstructured.Unstructured
+	Clusters []Cluster
+}
+
+// GetObjectKind returns a schema object.
+func (*FleetDisruptionWithClusters) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a container copy.
+func (f *FleetDisruptionWithClusters) DeepCopyObject() runtime.Object {
+	return f
+}
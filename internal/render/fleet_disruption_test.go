@@ -0,0 +1,91 @@
+package render_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAllowedUnavailable(t *testing.T) {
+	uu := map[string]struct {
+		max   string
+		total int
+		e     int
+		err   error
+	}{
+		"int":        {max: "2", total: 10, e: 2},
+		"percent":    {max: "10%", total: 101, e: 11},
+		"ceiling":    {max: "25%", total: 5, e: 2},
+		"zero":       {max: "0%", total: 5, e: 0},
+		"no-cluster": {max: "50%", total: 0, e: 0},
+		"empty":      {total: 5, err: errors.New("no max unavailable specified")},
+		"negative":   {max: "-1", total: 5, err: errors.New(`invalid max unavailable "-1"`)},
+		"toast":      {max: "fred", total: 5, err: errors.New(`invalid max unavailable "fred"`)},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			n, err := render.AllowedUnavailable(u.max, u.total)
+			assert.Equal(t, u.err, err)
+			assert.Equal(t, u.e, n)
+		})
+	}
+}
+
+func TestFleetDisruptionRender(t *testing.T) {
+	uu := map[string]struct {
+		cc []render.Cluster
+		e  render.Fields
+	}{
+		"available": {
+			cc: []render.Cluster{
+				makeFleetCluster("c1", render.HealthyClusterHealth, render.ActiveClusterActivity),
+				makeFleetCluster("c2", render.HealthyClusterHealth, ""),
+				makeFleetCluster("c3", render.HealthyClusterHealth, render.ActiveClusterActivity),
+				makeFleetCluster("c4", render.HealthyClusterHealth, render.ActiveClusterActivity),
+				makeFleetCluster("c5", render.FailedClusterHealth, render.ActiveClusterActivity),
+			},
+			e: render.Fields{"default", "fred", "region=east", "25%", "2", "1", "5", "Available", "c5", ""},
+		},
+		"exhausted": {
+			cc: []render.Cluster{
+				makeFleetCluster("c1", render.HealthyClusterHealth, render.DrainClusterActivity),
+				makeFleetCluster("c2", render.HealthyClusterHealth, render.ActiveClusterActivity),
+				makeFleetCluster("c3", render.HealthyClusterHealth, render.ActiveClusterActivity),
+			},
+			e: render.Fields{"default", "fred", "region=east", "25%", "1", "1", "3", "Exhausted", "c1", "budget exhausted: 1/1 clusters unavailable"},
+		},
+		"violated": {
+			cc: []render.Cluster{
+				makeFleetCluster("c1", render.PartialFailedClusterHealth, render.ActiveClusterActivity),
+				makeFleetCluster("c2", render.FailedClusterHealth, render.ActiveClusterActivity),
+			},
+			e: render.Fields{"default", "fred", "region=east", "25%", "1", "2", "2", "Violated", "c1,c2", "budget violated: 2/1 clusters unavailable"},
+		},
+	}
+
+	var r render.FleetDisruptionRenderer
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			var row render.Row
+			res := render.FleetDisruptionWithClusters{Raw: load(t, "fdb"), Clusters: u.cc}
+			assert.Nil(t, r.Render(&res, "", &row))
+			assert.Equal(t, "default/fred", row.ID)
+			assert.Equal(t, u.e, row.Fields[:len(row.Fields)-1])
+		})
+	}
+}
+
+// Helpers...
+
+func makeFleetCluster(n string, h render.ClusterHealthStatus, a render.ClusterActivityStatus) render.Cluster {
+	var cl render.Cluster
+	cl.Name = n
+	cl.Status.ClusterHealthStatus, cl.Status.ClusterActivityStatus = h, a
+
+	return cl
+}
@@ -174,7 +174,8 @@ func naStrings(ss []string) string {
 	return strings.Join(ss, ",")
 }
 
-func na(s string) string {
+// NA returns the not applicable marker for an empty value.
+func NA(s string) string {
 	return check(s, NAValue)
 }
 
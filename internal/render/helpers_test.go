@@ -205,7 +205,7 @@ func TestBoolToStr(t *testing.T) {
 	}
 }
 
-func TestNa(t *testing.T) {
+func TestNA(t *testing.T) {
 	uu := []struct {
 		i, e string
 	}{
@@ -214,7 +214,7 @@ func TestNa(t *testing.T) {
 	}
 
 	for _, u := range uu {
-		assert.Equal(t, u.e, na(u.i))
+		assert.Equal(t, u.e, NA(u.i))
 	}
 }
 
@@ -1,10 +1,10 @@
 package render
 
 import (
-	"encoding/json"
 	"fmt"
 
 	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/tview"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
@@ -16,12 +16,20 @@ type ManifestRenderer struct {
 	Base
 }
 
+// ColorerFunc colors a resource row.
+func (ManifestRenderer) ColorerFunc() ColorerFunc {
+	return ManifestColorer
+}
+
 // Header returns a header rbw.
 func (ManifestRenderer) Header(string) Header {
 	return Header{
 		HeaderColumn{Name: "MANIFEST"},
 		HeaderColumn{Name: "KIND"},
-		HeaderColumn{Name: "REPLICAS"},
+		HeaderColumn{Name: "REPLICAS", Align: tview.AlignRight},
+		HeaderColumn{Name: "READY", Align: tview.AlignRight},
+		HeaderColumn{Name: "STATUS"},
+		HeaderColumn{Name: "VALID", Wide: true},
 	}
 }
 
@@ -32,42 +40,24 @@ func (c ManifestRenderer) Render(o interface{}, ns string, r *Row) error {
 		return fmt.Errorf("Expected ManifestRes, but got %T", o)
 	}
 
-	replicas := "-"
-	if manifest.Kind == "Deployment" {
-		deploymentStatus, err := c.DeploymentStatusToMap(*manifest.Status)
-		if err == nil {
-			repl, _, _ := unstructured.NestedFloat64(*deploymentStatus, "replicas")
-			avl, found, _ := unstructured.NestedFloat64(*deploymentStatus, "availableReplicas")
-
-			if found {
-				replicas = fmt.Sprint(avl) + "/" + fmt.Sprint(repl)
-			} else {
-				replicas = "0/" + fmt.Sprint(repl)
-			}
-		}
+	h := InterpretManifestStatus(manifest.Kind, manifest.Object, manifest.Status)
+	if manifest.Err != nil {
+		h = ManifestHealth{Ready: NAValue, Status: ManifestInvalidStatus, Err: manifest.Err}
 	}
 
 	r.ID = client.FQN(manifest.Namespace, manifest.Name)
 	r.Fields = Fields{
 		manifest.Name,
 		manifest.Kind,
-		replicas,
+		manifest.Replicas,
+		h.Ready,
+		h.Status,
+		asStatus(h.Err),
 	}
 
 	return nil
 }
 
-func (c ManifestRenderer) DeploymentStatusToMap(
-	manifestStatus ManifestStatus,
-) (*map[string]interface{}, error) {
-	var status map[string]interface{}
-	err := json.Unmarshal(manifestStatus.Status.Raw, &status)
-	if err != nil {
-		return nil, err
-	}
-	return &status, nil
-}
-
 func GetKindFromUnstructured(unstructuredObj *unstructured.Unstructured) string {
 	value, found, err := unstructured.NestedString(unstructuredObj.Object, "kind")
 	if !found || err != nil {
@@ -164,7 +154,9 @@ type ManifestRes struct {
 	Namespace string
 	Kind      string
 	Replicas  string
+	Object    *unstructured.Unstructured
 	Status    *ManifestStatus
+	Err       error
 }
 
 // GetObjectKind returns a schema object.
@@ -0,0 +1,319 @@
+package render
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/derailed/tcell/v2"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+const (
+	// ManifestAvailableStatus represents a fully available manifest.
+	ManifestAvailableStatus = "Available"
+	// ManifestProgressingStatus represents a manifest rolling out.
+	ManifestProgressingStatus = "Progressing"
+	// ManifestPendingStatus represents a manifest waiting on resources.
+	ManifestPendingStatus = "Pending"
+	// ManifestRunningStatus represents a manifest that is actively running.
+	ManifestRunningStatus = "Running"
+	// ManifestInvalidStatus represents a manifest that could not be decoded.
+	ManifestInvalidStatus = "Invalid"
+)
+
+// ManifestHealth tracks a manifest health as interpreted from its status.
+type ManifestHealth struct {
+	// Ready tracks ready vs desired counts.
+	Ready string
+
+	// Status tracks a short status description.
+	Status string
+
+	// Err is set when the manifest is deemed unhealthy.
+	Err error
+}
+
+// ManifestInterpreter interprets a manifest status. The desired object may be nil if unknown.
+type ManifestInterpreter func(o *unstructured.Unstructured, status map[string]interface{}) ManifestHealth
+
+var (
+	manifestInterpreters = map[string]ManifestInterpreter{
+		"Deployment":  deploymentHealth,
+		"StatefulSet": statefulSetHealth,
+		"DaemonSet":   daemonSetHealth,
+		"Job":         jobHealth,
+		"Service":     serviceHealth,
+	}
+	interpretersMX sync.RWMutex
+)
+
+// RegisterManifestInterpreter registers a status interpreter for a given manifest kind.
+func RegisterManifestInterpreter(kind string, f ManifestInterpreter) {
+	interpretersMX.Lock()
+	defer interpretersMX.Unlock()
+
+	manifestInterpreters[kind] = f
+}
+
+// InterpretManifestStatus returns a manifest health based on its kind.
+// Kinds without a registered interpreter fallback to their status conditions.
+func InterpretManifestStatus(kind string, o *unstructured.Unstructured, ms *ManifestStatus) ManifestHealth {
+	if ms == nil || len(ms.Status.Raw) == 0 {
+		return ManifestHealth{Ready: NAValue, Status: UnknownValue}
+	}
+	status, err := manifestStatusToMap(*ms)
+	if err != nil {
+		return ManifestHealth{Ready: NAValue, Status: UnknownValue, Err: err}
+	}
+
+	interpretersMX.RLock()
+	f, ok := manifestInterpreters[kind]
+	interpretersMX.RUnlock()
+	if !ok {
+		f = conditionsHealth
+	}
+
+	return f(o, status)
+}
+
+// ManifestColorer colors a manifest row based on its status.
+func ManifestColorer(ns string, h Header, re RowEvent) tcell.Color {
+	c := DefaultColorer(ns, h, re)
+	if c == ErrColor {
+		return c
+	}
+	statusCol := h.IndexOf("STATUS", true)
+	if statusCol == -1 {
+		return c
+	}
+	status := strings.TrimSpace(re.Row.Fields[statusCol])
+	switch {
+	case status == UnknownValue:
+		return CompletedColor
+	case isManifestPending(status):
+		return PendingColor
+	default:
+		return c
+	}
+}
+
+func isManifestPending(status string) bool {
+	switch status {
+	case ManifestProgressingStatus, ManifestPendingStatus, ManifestRunningStatus, UnknownValue:
+		return true
+	default:
+		return false
+	}
+}
+
+func manifestStatusToMap(ms ManifestStatus) (map[string]interface{}, error) {
+	var status map[string]interface{}
+	if len(ms.Status.Raw) == 0 {
+		return status, nil
+	}
+	if err := json.Unmarshal(ms.Status.Raw, &status); err != nil {
+		return nil, err
+	}
+
+	return status, nil
+}
+
+func deploymentHealth(o *unstructured.Unstructured, status map[string]interface{}) ManifestHealth {
+	desired, avail := nestedInt(status, "replicas"), nestedInt(status, "availableReplicas")
+	h := ManifestHealth{Ready: readyRatio(avail, desired), Status: ManifestAvailableStatus}
+	if c, ok := findCondition(status, "Available"); ok && c.status != "True" {
+		h.Status, h.Err = "Unavailable", c.asError()
+		return h
+	}
+	if c, ok := findCondition(status, "Progressing"); ok && c.status == "False" {
+		h.Status, h.Err = "Stalled", c.asError()
+		return h
+	}
+	if avail < desired || nestedInt(status, "updatedReplicas") < desired {
+		h.Status = ManifestProgressingStatus
+	}
+
+	return h
+}
+
+func statefulSetHealth(o *unstructured.Unstructured, status map[string]interface{}) ManifestHealth {
+	desired, ready := nestedInt(status, "replicas"), nestedInt(status, "readyReplicas")
+	h := ManifestHealth{Ready: readyRatio(ready, desired), Status: ManifestAvailableStatus}
+	current, _, _ := unstructured.NestedString(status, "currentRevision")
+	update, _, _ := unstructured.NestedString(status, "updateRevision")
+	if ready < desired || (update != "" && current != update) {
+		h.Status = ManifestProgressingStatus
+	}
+
+	return h
+}
+
+func daemonSetHealth(o *unstructured.Unstructured, status map[string]interface{}) ManifestHealth {
+	desired, ready := nestedInt(status, "desiredNumberScheduled"), nestedInt(status, "numberReady")
+	h := ManifestHealth{Ready: readyRatio(ready, desired), Status: ManifestAvailableStatus}
+	if n := nestedInt(status, "numberMisscheduled"); n > 0 {
+		h.Status, h.Err = "Misscheduled", fmt.Errorf("%d daemon pods misscheduled", n)
+		return h
+	}
+	if ready < desired {
+		h.Status = ManifestProgressingStatus
+	}
+
+	return h
+}
+
+func jobHealth(o *unstructured.Unstructured, status map[string]interface{}) ManifestHealth {
+	succeeded, active := nestedInt(status, "succeeded"), nestedInt(status, "active")
+	completions := int64(1)
+	if o != nil {
+		if c, ok, _ := unstructured.NestedInt64(o.Object, "spec", "completions"); ok {
+			completions = c
+		}
+	}
+	h := ManifestHealth{Ready: readyRatio(succeeded, completions)}
+	if c, ok := findCondition(status, "Failed"); ok && c.status == "True" {
+		h.Status, h.Err = "Failed", c.asError()
+		return h
+	}
+	if c, ok := findCondition(status, "Complete"); ok && c.status == "True" {
+		h.Status = "Complete"
+		return h
+	}
+	h.Status = ManifestPendingStatus
+	if active > 0 {
+		h.Status = ManifestRunningStatus
+	}
+
+	return h
+}
+
+func serviceHealth(o *unstructured.Unstructured, status map[string]interface{}) ManifestHealth {
+	h := ManifestHealth{Ready: NAValue, Status: "Active"}
+	var lb bool
+	if o != nil {
+		t, _, _ := unstructured.NestedString(o.Object, "spec", "type")
+		lb = t == "LoadBalancer"
+	}
+	ingress, _, _ := unstructured.NestedSlice(status, "loadBalancer", "ingress")
+	if len(ingress) == 0 {
+		if lb {
+			h.Status = ManifestPendingStatus
+		}
+		return h
+	}
+	ee := make([]string, 0, len(ingress))
+	for _, i := range ingress {
+		m, ok := i.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if ip, _, _ := unstructured.NestedString(m, "ip"); ip != "" {
+			ee = append(ee, ip)
+			continue
+		}
+		if host, _, _ := unstructured.NestedString(m, "hostname"); host != "" {
+			ee = append(ee, host)
+		}
+	}
+	h.Ready = readyRatio(int64(len(ee)), int64(len(ingress)))
+	if len(ee) > 0 {
+		h.Status = strings.Join(ee, ",")
+	}
+
+	return h
+}
+
+// conditionsHealth interprets a generic conditions array as typically found on CRDs.
+func conditionsHealth(o *unstructured.Unstructured, status map[string]interface{}) ManifestHealth {
+	cc := conditions(status)
+	if len(cc) == 0 {
+		return ManifestHealth{Ready: NAValue, Status: UnknownValue}
+	}
+	var ok int64
+	for _, c := range cc {
+		if c.status == "True" {
+			ok++
+		}
+	}
+	h := ManifestHealth{Ready: readyRatio(ok, int64(len(cc)))}
+	for _, t := range []string{"Ready", "Available", "Healthy", "Synced"} {
+		c, found := findCondition(status, t)
+		if !found {
+			continue
+		}
+		if c.status == "True" {
+			h.Status = t
+			return h
+		}
+		h.Status, h.Err = "Not"+t, c.asError()
+		return h
+	}
+	h.Status = cc[len(cc)-1].kind
+
+	return h
+}
+
+type manifestCondition struct {
+	kind, status, reason string
+}
+
+func (c manifestCondition) asError() error {
+	if c.reason == "" {
+		return fmt.Errorf("condition %s is %s", c.kind, c.status)
+	}
+
+	return fmt.Errorf("condition %s is %s: %s", c.kind, c.status, c.reason)
+}
+
+func conditions(status map[string]interface{}) []manifestCondition {
+	raw, _, _ := unstructured.NestedSlice(status, "conditions")
+	cc := make([]manifestCondition, 0, len(raw))
+	for _, r := range raw {
+		m, ok := r.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		var c manifestCondition
+		c.kind, _, _ = unstructured.NestedString(m, "type")
+		c.status, _, _ = unstructured.NestedString(m, "status")
+		c.reason, _, _ = unstructured.NestedString(m, "reason")
+		cc = append(cc, c)
+	}
+
+	return cc
+}
+
+func findCondition(status map[string]interface{}, kind string) (manifestCondition, bool) {
+	for _, c := range conditions(status) {
+		if c.kind == kind {
+			return c, true
+		}
+	}
+
+	return manifestCondition{}, false
+}
+
+// nestedInt returns a json number field as an int, json numbers being decoded as floats.
+func nestedInt(m map[string]interface{}, fields ...string) int64 {
+	v, found, err := unstructured.NestedFieldNoCopy(m, fields...)
+	if !found || err != nil {
+		return 0
+	}
+	switch n := v.(type) {
+	case float64:
+		return int64(n)
+	case int64:
+		return n
+	case int:
+		return int64(n)
+	default:
+		return 0
+	}
+}
+
+func readyRatio(ready, desired int64) string {
+	return strconv.FormatInt(ready, 10) + "/" + strconv.FormatInt(desired, 10)
+}
@@ -0,0 +1,127 @@
+package render_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestInterpretManifestStatus(t *testing.T) {
+	lb := &unstructured.Unstructured{Object: map[string]interface{}{
+		"spec": map[string]interface{}{"type": "LoadBalancer"},
+	}}
+	job := &unstructured.Unstructured{Object: map[string]interface{}{
+		"spec": map[string]interface{}{"completions": int64(5)},
+	}}
+
+	uu := map[string]struct {
+		kind, status   string
+		o              *unstructured.Unstructured
+		ready, eStatus string
+		err            bool
+	}{
+		"no-status": {
+			kind:    "Deployment",
+			ready:   "n/a",
+			eStatus: "<unknown>",
+		},
+		"dp-rolling": {
+			kind:    "Deployment",
+			status:  `{"replicas":3,"updatedReplicas":1,"availableReplicas":2}`,
+			ready:   "2/3",
+			eStatus: "Progressing",
+		},
+		"sts-ready": {
+			kind:    "StatefulSet",
+			status:  `{"replicas":2,"readyReplicas":2,"currentRevision":"r1","updateRevision":"r1"}`,
+			ready:   "2/2",
+			eStatus: "Available",
+		},
+		"sts-updating": {
+			kind:    "StatefulSet",
+			status:  `{"replicas":2,"readyReplicas":2,"currentRevision":"r1","updateRevision":"r2"}`,
+			ready:   "2/2",
+			eStatus: "Progressing",
+		},
+		"ds-partial": {
+			kind:    "DaemonSet",
+			status:  `{"desiredNumberScheduled":5,"numberReady":4}`,
+			ready:   "4/5",
+			eStatus: "Progressing",
+		},
+		"ds-misscheduled": {
+			kind:    "DaemonSet",
+			status:  `{"desiredNumberScheduled":5,"numberReady":5,"numberMisscheduled":1}`,
+			ready:   "5/5",
+			eStatus: "Misscheduled",
+			err:     true,
+		},
+		"job-complete": {
+			kind:    "Job",
+			status:  `{"succeeded":1,"conditions":[{"type":"Complete","status":"True"}]}`,
+			ready:   "1/1",
+			eStatus: "Complete",
+		},
+		"job-failed": {
+			kind:    "Job",
+			status:  `{"failed":3,"conditions":[{"type":"Failed","status":"True","reason":"BackoffLimitExceeded"}]}`,
+			ready:   "0/1",
+			eStatus: "Failed",
+			err:     true,
+		},
+		"job-running": {
+			kind:    "Job",
+			status:  `{"succeeded":2,"failed":1,"active":1}`,
+			o:       job,
+			ready:   "2/5",
+			eStatus: "Running",
+		},
+		"svc-cluster-ip": {
+			kind:    "Service",
+			status:  `{"loadBalancer":{}}`,
+			ready:   "n/a",
+			eStatus: "Active",
+		},
+		"svc-lb-pending": {
+			kind:    "Service",
+			status:  `{"loadBalancer":{}}`,
+			o:       lb,
+			ready:   "n/a",
+			eStatus: "Pending",
+		},
+		"svc-lb": {
+			kind:    "Service",
+			status:  `{"loadBalancer":{"ingress":[{"ip":"10.0.0.1"},{"hostname":"fred.io"}]}}`,
+			o:       lb,
+			ready:   "2/2",
+			eStatus: "10.0.0.1,fred.io",
+		},
+		"crd-ready": {
+			kind:    "Certificate",
+			status:  `{"conditions":[{"type":"Issuing","status":"False"},{"type":"Ready","status":"True"}]}`,
+			ready:   "1/2",
+			eStatus: "Ready",
+		},
+		"crd-not-ready": {
+			kind:    "Certificate",
+			status:  `{"conditions":[{"type":"Ready","status":"False","reason":"Pending"}]}`,
+			ready:   "0/1",
+			eStatus: "NotReady",
+			err:     true,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			ms := render.ManifestStatus{Kind: u.kind, Status: runtime.RawExtension{Raw: []byte(u.status)}}
+			h := render.InterpretManifestStatus(u.kind, u.o, &ms)
+			assert.Equal(t, u.ready, h.Ready)
+			assert.Equal(t, u.eStatus, h.Status)
+			assert.Equal(t, u.err, h.Err != nil)
+		})
+	}
+}
@@ -0,0 +1,35 @@
+package render_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestManifestRender(t *testing.T) {
+	uu := map[string]struct {
+		res render.ManifestRes
+		e   render.Fields
+	}{
+		"no-status": {
+			res: render.ManifestRes{Name: "blee_Deployment", Namespace: "fred", Kind: "Deployment", Replicas: "3"},
+			e:   render.Fields{"blee_Deployment", "Deployment", "3", "n/a", "<unknown>", ""},
+		},
+		"invalid": {
+			res: render.ManifestRes{Name: "manifest-1", Replicas: "-", Err: errors.New("failed to convert manifest.Raw")},
+			e:   render.Fields{"manifest-1", "", "-", "n/a", "Invalid", "failed to convert manifest.Raw"},
+		},
+	}
+
+	var m render.ManifestRenderer
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			var r render.Row
+			assert.Nil(t, m.Render(u.res, "", &r))
+			assert.Equal(t, u.e, r.Fields)
+		})
+	}
+}
@@ -0,0 +1,100 @@
+package render
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/tcell/v2"
+	"github.com/derailed/tview"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// ManifestWorkRenderer renders a fleet ManifestWork to screen.
+type ManifestWorkRenderer struct {
+	Base
+}
+
+// ColorerFunc colors a resource row.
+func (ManifestWorkRenderer) ColorerFunc() ColorerFunc {
+	return func(ns string, h Header, re RowEvent) tcell.Color {
+		c := DefaultColorer(ns, h, re)
+		reasonCol := h.IndexOf("DEGRADED-REASON", true)
+		if reasonCol != -1 && strings.TrimSpace(re.Row.Fields[reasonCol]) == string(ManifestDegradedNoEnoughResource) {
+			return KillColor
+		}
+		if c == ErrColor {
+			return c
+		}
+		stateCol := h.IndexOf("STATE", true)
+		if stateCol == -1 {
+			return c
+		}
+		switch ManifestWorkState(strings.TrimSpace(re.Row.Fields[stateCol])) {
+		case ManifestDegraded:
+			return ErrColor
+		case ManifestProgressing, ManifestApplied:
+			return PendingColor
+		default:
+			return c
+		}
+	}
+}
+
+// Header returns a header row.
+func (ManifestWorkRenderer) Header(string) Header {
+	return Header{
+		HeaderColumn{Name: "NAMESPACE"},
+		HeaderColumn{Name: "NAME"},
+		HeaderColumn{Name: "STATE"},
+		HeaderColumn{Name: "HEALTHY", Align: tview.AlignRight},
+		HeaderColumn{Name: "NON-SCHEDULABLE", Align: tview.AlignRight},
+		HeaderColumn{Name: "TOTAL", Align: tview.AlignRight},
+		HeaderColumn{Name: "MANIFESTS", Align: tview.AlignRight},
+		HeaderColumn{Name: "DEGRADED-REASON"},
+		HeaderColumn{Name: "VALID", Wide: true},
+		HeaderColumn{Name: "AGE", Time: true},
+	}
+}
+
+// Render renders a K8s resource to screen.
+func (m ManifestWorkRenderer) Render(o interface{}, ns string, r *Row) error {
+	raw, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return fmt.Errorf("Expected ManifestWork, but got %T", o)
+	}
+	var mw ManifestWork
+	err := runtime.DefaultUnstructuredConverter.FromUnstructured(raw.Object, &mw)
+	if err != nil {
+		return err
+	}
+
+	r.ID = client.MetaFQN(mw.ObjectMeta)
+	r.Fields = Fields{
+		mw.Namespace,
+		mw.Name,
+		NA(string(mw.Status.ManifestState)),
+		strconv.Itoa(mw.Status.HealthReplicas),
+		strconv.Itoa(mw.Status.NonSchedulableReplicas),
+		strconv.Itoa(mw.Status.TotalReplicas),
+		strconv.Itoa(len(mw.Spec.Workload.Manifests)),
+		NA(string(mw.Status.DegradedReason)),
+		asStatus(m.diagnose(mw.Status)),
+		toAge(mw.GetCreationTimestamp()),
+	}
+
+	return nil
+}
+
+func (ManifestWorkRenderer) diagnose(st ManifestWorkStatus) error {
+	switch {
+	case st.DegradedReason == ManifestDegradedNoEnoughResource:
+		return fmt.Errorf("not enough resources: %d/%d replicas non schedulable", st.NonSchedulableReplicas, st.TotalReplicas)
+	case st.ManifestState == ManifestDegraded:
+		return fmt.Errorf("degraded: %d/%d replicas healthy", st.HealthReplicas, st.TotalReplicas)
+	default:
+		return nil
+	}
+}
@@ -0,0 +1,29 @@
+package render_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestManifestWorkRender(t *testing.T) {
+	var (
+		m render.ManifestWorkRenderer
+		r render.Row
+	)
+
+	assert.Nil(t, m.Render(load(t, "mw"), "", &r))
+	assert.Equal(t, "c1/fred", r.ID)
+	assert.Equal(t, render.Fields{
+		"c1",
+		"fred",
+		"Degraded",
+		"1",
+		"2",
+		"3",
+		"1",
+		"NoEnoughResouce",
+		"not enough resources: 2/3 replicas non schedulable",
+	}, r.Fields[:len(r.Fields)-1])
+}
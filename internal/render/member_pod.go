@@ -0,0 +1,81 @@
+package render
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/tcell/v2"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// UnreachableStatus tracks a member cluster that could not be listed.
+const UnreachableStatus = "Unreachable"
+
+// MemberPod renders a pod living on a fleet member cluster to screen.
+type MemberPod struct {
+	Pod
+}
+
+// ColorerFunc colors a resource row.
+func (m MemberPod) ColorerFunc() ColorerFunc {
+	return func(ns string, h Header, re RowEvent) tcell.Color {
+		statusCol := h.IndexOf("STATUS", true)
+		if statusCol != -1 && strings.TrimSpace(re.Row.Fields[statusCol]) == UnreachableStatus {
+			return ErrColor
+		}
+
+		return m.Pod.ColorerFunc()(ns, h, re)
+	}
+}
+
+// Header returns a header row.
+func (m MemberPod) Header(ns string) Header {
+	return append(Header{HeaderColumn{Name: "CLUSTER"}}, m.Pod.Header(ns)...)
+}
+
+// Render renders a K8s resource to screen.
+func (m MemberPod) Render(o interface{}, ns string, row *Row) error {
+	res, ok := o.(*MemberPodRes)
+	if !ok {
+		return fmt.Errorf("Expected MemberPodRes, but got %T", o)
+	}
+
+	if res.Err != nil {
+		h := m.Header(ns)
+		row.ID = res.Cluster
+		row.Fields = make(Fields, len(h))
+		row.Fields[0] = res.Cluster
+		row.Fields[h.IndexOf("NAME", true)] = res.Err.Error()
+		row.Fields[h.IndexOf("STATUS", true)] = UnreachableStatus
+		row.Fields[h.IndexOf("VALID", true)] = res.Err.Error()
+		return nil
+	}
+
+	var r Row
+	if err := m.Pod.Render(res.Pod, ns, &r); err != nil {
+		return err
+	}
+	row.ID = client.FQN(res.Cluster, r.ID)
+	row.Fields = append(Fields{res.Cluster}, r.Fields...)
+
+	return nil
+}
+
+// MemberPodRes represents a pod on a member cluster or the error reaching that cluster.
+type MemberPodRes struct {
+	Cluster string
+	Pod     *PodWithMetrics
+	Err     error
+}
+
+// GetObjectKind returns a schema object.
+func (m *MemberPodRes) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a container copy.
+func (m *MemberPodRes) DeepCopyObject() runtime.Object {
+	return m
+}
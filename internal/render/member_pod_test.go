@@ -0,0 +1,41 @@
+package render_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMemberPodRender(t *testing.T) {
+	res := render.MemberPodRes{
+		Cluster: "c1",
+		Pod:     &render.PodWithMetrics{Raw: load(t, "po")},
+	}
+
+	var m render.MemberPod
+	r := render.NewRow(23)
+	assert.Nil(t, m.Render(&res, "", &r))
+	assert.Equal(t, "c1/default/nginx", r.ID)
+	assert.Equal(t, render.Fields{"c1", "default", "nginx", "●", "1/1", "0", "Running"}, r.Fields[:7])
+	assert.Equal(t, len(m.Header("")), len(r.Fields))
+}
+
+func TestMemberPodRenderUnreachable(t *testing.T) {
+	res := render.MemberPodRes{
+		Cluster: "c2",
+		Err:     errors.New("unable to connect to cluster \"c2\""),
+	}
+
+	var m render.MemberPod
+	r := render.NewRow(23)
+	assert.Nil(t, m.Render(&res, "", &r))
+
+	h := m.Header("")
+	assert.Equal(t, "c2", r.ID)
+	assert.Equal(t, len(h), len(r.Fields))
+	assert.Equal(t, "c2", r.Fields[h.IndexOf("CLUSTER", true)])
+	assert.Equal(t, render.UnreachableStatus, r.Fields[h.IndexOf("STATUS", true)])
+	assert.Equal(t, `unable to connect to cluster "c2"`, r.Fields[h.IndexOf("NAME", true)])
+}
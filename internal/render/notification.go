@@ -0,0 +1,126 @@
+package render
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/derailed/tcell/v2"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+const (
+	// NotifyError designates an error notification.
+	NotifyError = "error"
+
+	// NotifyWarn designates a warning notification.
+	NotifyWarn = "warn"
+
+	// NotifyInfo designates an info notification.
+	NotifyInfo = "info"
+)
+
+// Notification renders a fleet notification to screen.
+type Notification struct {
+	Base
+}
+
+// ColorerFunc colors a resource row.
+func (Notification) ColorerFunc() ColorerFunc {
+	return func(ns string, h Header, re RowEvent) tcell.Color {
+		col := h.IndexOf("LEVEL", true)
+		if col == -1 {
+			return DefaultColorer(ns, h, re)
+		}
+		switch strings.TrimSpace(re.Row.Fields[col]) {
+		case NotifyError:
+			return ErrColor
+		case NotifyWarn:
+			return PendingColor
+		default:
+			return StdColor
+		}
+	}
+}
+
+// Header returns a header row.
+func (Notification) Header(string) Header {
+	return Header{
+		HeaderColumn{Name: "LEVEL"},
+		HeaderColumn{Name: "KIND"},
+		HeaderColumn{Name: "NAME"},
+		HeaderColumn{Name: "FIELD"},
+		HeaderColumn{Name: "FROM"},
+		HeaderColumn{Name: "TO"},
+		HeaderColumn{Name: "RULE"},
+		HeaderColumn{Name: "CONTEXT", Wide: true},
+		HeaderColumn{Name: "HOOK", Wide: true},
+		HeaderColumn{Name: "AGE", Time: true},
+	}
+}
+
+// Render renders a K8s resource to screen.
+func (Notification) Render(o interface{}, ns string, r *Row) error {
+	n, ok := o.(*FleetNotification)
+	if !ok {
+		return fmt.Errorf("Expected FleetNotification, but got %T", o)
+	}
+
+	r.ID = fmt.Sprintf("%06d", n.ID)
+	r.Fields = Fields{
+		n.Level,
+		n.Kind,
+		n.FQN(),
+		n.Field,
+		NA(n.From),
+		NA(n.To),
+		n.Rule,
+		n.Context,
+		NA(n.Hook),
+		toAge(metav1.NewTime(n.Time)),
+	}
+
+	return nil
+}
+
+// FleetNotification represents a fleet state transition notification.
+type FleetNotification struct {
+	ID        int       `json:"-"`
+	Time      time.Time `json:"time"`
+	Level     string    `json:"level"`
+	Rule      string    `json:"rule"`
+	Context   string    `json:"context"`
+	Kind      string    `json:"kind"`
+	Namespace string    `json:"namespace,omitempty"`
+	Name      string    `json:"name"`
+	Field     string    `json:"field"`
+	From      string    `json:"from"`
+	To        string    `json:"to"`
+	Hook      string    `json:"-"`
+}
+
+// FQN returns the notified resource fully qualified name.
+func (n *FleetNotification) FQN() string {
+	if n.Namespace == "" {
+		return n.Name
+	}
+
+	return n.Namespace + "/" + n.Name
+}
+
+// Message returns a human readable notification.
+func (n *FleetNotification) Message() string {
+	return fmt.Sprintf("%s %s %s changed %s -> %s", n.Kind, n.FQN(), n.Field, NA(n.From), NA(n.To))
+}
+
+// GetObjectKind returns a schema object.
+func (n *FleetNotification) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a container copy.
+func (n *FleetNotification) DeepCopyObject() runtime.Object {
+	return n
+}
@@ -0,0 +1,142 @@
+package render
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/derailed/tcell/v2"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// ComponentCapabilities tracks all known cluster component capabilities.
+var ComponentCapabilities = []ComponentCapability{
+	ComponentCapabilityInfra,
+	ComponentCapabilityData,
+	ComponentCapabilityMonitoring,
+	ComponentCapabilityHealth,
+	ComponentCapabilityFramework,
+	ComponentCapabilityRouting,
+	ComponentCapabilityLogs,
+	ComponentCapabilityIngress,
+	ComponentCapabilityDiscovery,
+}
+
+// LookupCapability returns a known component capability matching a name regardless of case.
+func LookupCapability(s string) (ComponentCapability, bool) {
+	for _, c := range ComponentCapabilities {
+		if strings.EqualFold(string(c), s) {
+			return c, true
+		}
+	}
+
+	return "", false
+}
+
+// Placement renders an application placement preview to screen.
+type Placement struct {
+	Base
+}
+
+// ColorerFunc colors a resource row.
+func (Placement) ColorerFunc() ColorerFunc {
+	return func(ns string, h Header, re RowEvent) tcell.Color {
+		col := h.IndexOf("ELIGIBLE", true)
+		if col == -1 {
+			return DefaultColorer(ns, h, re)
+		}
+		if strings.TrimSpace(re.Row.Fields[col]) == "false" {
+			return ErrColor
+		}
+
+		return StdColor
+	}
+}
+
+// Header returns a header row.
+func (Placement) Header(string) Header {
+	return Header{
+		HeaderColumn{Name: "CLUSTER"},
+		HeaderColumn{Name: "ELIGIBLE"},
+		HeaderColumn{Name: "CAPABILITY"},
+		HeaderColumn{Name: "POLICY-STATUS"},
+		HeaderColumn{Name: "FAILURES", Wide: true},
+		HeaderColumn{Name: "REQUIRED", Wide: true},
+	}
+}
+
+// Render renders a K8s resource to screen.
+func (Placement) Render(o interface{}, ns string, r *Row) error {
+	res, ok := o.(*PlacementRes)
+	if !ok {
+		return fmt.Errorf("Expected PlacementRes, but got %T", o)
+	}
+
+	capability, status := NAValue, NAValue
+	ff := make([]string, 0, len(res.Failures))
+	for i, f := range res.Failures {
+		s := policyStatusOrMissing(f.Status)
+		if i == 0 {
+			capability, status = string(f.Capability), s
+		}
+		ff = append(ff, string(f.Capability)+":"+s)
+	}
+
+	r.ID = res.Cluster
+	r.Fields = Fields{
+		res.Cluster,
+		boolToStr(len(res.Failures) == 0),
+		capability,
+		status,
+		strings.Join(ff, ","),
+		strings.Join(res.Required, ","),
+	}
+
+	return nil
+}
+
+func policyStatusOrMissing(s PolicyStatus) string {
+	if s == "" {
+		return MissingValue
+	}
+
+	return string(s)
+}
+
+// CapabilityFailure represents a required capability a cluster does not satisfy.
+type CapabilityFailure struct {
+	Capability ComponentCapability
+	Status     PolicyStatus
+}
+
+// PlacementRes represents an application placement evaluation against a fleet cluster.
+type PlacementRes struct {
+	Cluster  string
+	Required []string
+	Failures []CapabilityFailure
+}
+
+// GetObjectKind returns a schema object.
+func (p *PlacementRes) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a container copy.
+func (p *PlacementRes) DeepCopyObject() runtime.Object {
+	return p
+}
+
+// EvaluatePlacement checks a cluster runtime status against a set of required capabilities.
+// A capability is only satisfied when its policy status is healthy. The failures
+// are returned in the order the capabilities were required.
+func EvaluatePlacement(required []string, rs RuntimeStatus) []CapabilityFailure {
+	var ff []CapabilityFailure
+	for _, c := range required {
+		s := rs.ClusterCapabilitiesStatus[ComponentCapability(c)]
+		if s != PolicyStatusHealthy {
+			ff = append(ff, CapabilityFailure{Capability: ComponentCapability(c), Status: s})
+		}
+	}
+
+	return ff
+}
@@ -0,0 +1,69 @@
+package render_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEvaluatePlacement(t *testing.T) {
+	rs := render.RuntimeStatus{
+		ClusterCapabilitiesStatus: map[render.ComponentCapability]render.PolicyStatus{
+			render.ComponentCapabilityInfra:   render.PolicyStatusHealthy,
+			render.ComponentCapabilityIngress: render.PolicyStatusUnhealthy,
+			render.ComponentCapabilityLogs:    render.PolicyStatusPartialHealthy,
+		},
+	}
+
+	uu := map[string]struct {
+		required []string
+		e        []render.CapabilityFailure
+	}{
+		"none": {},
+		"eligible": {
+			required: []string{"Infra"},
+		},
+		"unhealthy": {
+			required: []string{"Infra", "Ingress"},
+			e: []render.CapabilityFailure{
+				{Capability: render.ComponentCapabilityIngress, Status: render.PolicyStatusUnhealthy},
+			},
+		},
+		"missing": {
+			required: []string{"Data", "Logs"},
+			e: []render.CapabilityFailure{
+				{Capability: render.ComponentCapabilityData},
+				{Capability: render.ComponentCapabilityLogs, Status: render.PolicyStatusPartialHealthy},
+			},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, render.EvaluatePlacement(u.required, rs))
+		})
+	}
+}
+
+func TestPlacementRender(t *testing.T) {
+	res := render.PlacementRes{
+		Cluster:  "c1",
+		Required: []string{"Data", "Ingress"},
+		Failures: []render.CapabilityFailure{
+			{Capability: render.ComponentCapabilityData},
+			{Capability: render.ComponentCapabilityIngress, Status: render.PolicyStatusUnhealthy},
+		},
+	}
+
+	var p render.Placement
+	r := render.NewRow(6)
+	assert.Nil(t, p.Render(&res, "", &r))
+	assert.Equal(t, "c1", r.ID)
+	assert.Equal(t, render.Fields{"c1", "false", "Data", "<none>", "Data:<none>,Ingress:Unhealthy", "Data,Ingress"}, r.Fields)
+
+	res.Failures = nil
+	assert.Nil(t, p.Render(&res, "", &r))
+	assert.Equal(t, render.Fields{"c1", "true", "n/a", "n/a", "", "Data,Ingress"}, r.Fields)
+}
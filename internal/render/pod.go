@@ -118,8 +118,8 @@ func (p Pod) Render(o interface{}, ns string, row *Row) error {
 		client.ToPercentageStr(c.cpu, r.lcpu),
 		client.ToPercentageStr(c.mem, r.mem),
 		client.ToPercentageStr(c.mem, r.lmem),
-		na(po.Status.PodIP),
-		na(po.Spec.NodeName),
+		NA(po.Status.PodIP),
+		NA(po.Spec.NodeName),
 		p.mapQOS(po.Status.QOSClass),
 		mapToStr(po.Labels),
 		asStatus(p.diagnose(phase, cr, len(ss))),
@@ -0,0 +1,195 @@
+package render
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/derailed/tcell/v2"
+	"github.com/derailed/tview"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// A collection of cluster definition phases.
+const (
+	PreTasksPhase  = "PreTasks"
+	TasksPhase     = "Tasks"
+	PostTasksPhase = "PostTasks"
+)
+
+// ProvisioningStepRenderer renders a fleet cluster provisioning step to screen.
+type ProvisioningStepRenderer struct {
+	Base
+}
+
+// ColorerFunc colors a resource row.
+func (ProvisioningStepRenderer) ColorerFunc() ColorerFunc {
+	return func(ns string, h Header, re RowEvent) tcell.Color {
+		c := DefaultColorer(ns, h, re)
+		if c == ErrColor {
+			return c
+		}
+		statusCol := h.IndexOf("STATUS", true)
+		if statusCol == -1 {
+			return c
+		}
+		switch {
+		case IsStepFailed(re.Row.Fields[statusCol]):
+			return ErrColor
+		case IsStepCompleted(re.Row.Fields[statusCol]):
+			return c
+		case strings.TrimSpace(re.Row.Fields[statusCol]) == MissingValue:
+			return CompletedColor
+		default:
+			return PendingColor
+		}
+	}
+}
+
+// Header returns a header row.
+func (ProvisioningStepRenderer) Header(string) Header {
+	return Header{
+		HeaderColumn{Name: "#", Align: tview.AlignRight},
+		HeaderColumn{Name: "PHASE"},
+		HeaderColumn{Name: "NAME"},
+		HeaderColumn{Name: "TYPE"},
+		HeaderColumn{Name: "STATUS"},
+		HeaderColumn{Name: "INPUTS"},
+		HeaderColumn{Name: "ERROR"},
+		HeaderColumn{Name: "OUTPUTS", Wide: true},
+		HeaderColumn{Name: "VALID", Wide: true},
+	}
+}
+
+// Render renders a provisioning step to screen.
+func (ProvisioningStepRenderer) Render(o interface{}, ns string, r *Row) error {
+	s, ok := o.(ProvisioningStepRes)
+	if !ok {
+		return fmt.Errorf("Expected ProvisioningStepRes, but got %T", o)
+	}
+
+	var (
+		kind, status, errMsg = MissingValue, MissingValue, ""
+		outputs              string
+	)
+	if s.Task != nil {
+		kind = s.Task.TaskType
+	}
+	if s.Step != nil {
+		if s.Step.Type != "" {
+			kind = s.Step.Type
+		}
+		status, errMsg, outputs = NA(s.Step.Status), s.Step.ErrorMessage, mapToStr(s.Step.Properties)
+	}
+	ii := make([]string, 0, len(s.Inputs))
+	for _, in := range s.Inputs {
+		ii = append(ii, in.String())
+	}
+
+	r.ID = s.Name
+	r.Fields = Fields{
+		strconv.Itoa(s.Index),
+		s.Phase,
+		s.Name,
+		kind,
+		status,
+		naStrings(ii),
+		errMsg,
+		outputs,
+		asStatus(s.diagnose()),
+	}
+
+	return nil
+}
+
+// IsStepFailed checks if a provisioning step status denotes a failure.
+func IsStepFailed(status string) bool {
+	s := strings.ToLower(status)
+	return strings.Contains(s, "fail") || strings.Contains(s, "error")
+}
+
+// IsStepCompleted checks if a provisioning step status denotes a completion.
+func IsStepCompleted(status string) bool {
+	switch strings.ToLower(strings.TrimSpace(status)) {
+	case "completed", "complete", "succeeded", "success", "done":
+		return true
+	default:
+		return false
+	}
+}
+
+// TaskInput represents a task property fed from another task output.
+type TaskInput struct {
+	Property string
+	Ref      ClusterDefinitionTaskRef
+	Value    string
+	Resolved bool
+}
+
+func (t TaskInput) String() string {
+	s := t.Property + "<-" + t.Ref.TaskName + "." + t.Ref.OutputProperty
+	if !t.Resolved {
+		return s + "=" + MissingValue
+	}
+
+	return s + "=" + t.Value
+}
+
+// ResolveTaskInputs resolves a task value references against the provisioning steps outputs.
+func ResolveTaskInputs(task ClusterDefinitionTask, steps map[string]StepStatus) []TaskInput {
+	kk := make([]string, 0, len(task.Properties))
+	for k, v := range task.Properties {
+		if v.ValueFrom.TaskName != "" {
+			kk = append(kk, k)
+		}
+	}
+	sort.Strings(kk)
+
+	ii := make([]TaskInput, 0, len(kk))
+	for _, k := range kk {
+		in := TaskInput{Property: k, Ref: task.Properties[k].ValueFrom}
+		if st, ok := steps[in.Ref.TaskName]; ok {
+			in.Value, in.Resolved = st.Properties[in.Ref.OutputProperty]
+		}
+		ii = append(ii, in)
+	}
+
+	return ii
+}
+
+// ProvisioningStepRes represents a cluster definition task along with its provisioning step status.
+type ProvisioningStepRes struct {
+	Index  int
+	Phase  string
+	Name   string
+	Task   *ClusterDefinitionTask
+	Step   *StepStatus
+	Inputs []TaskInput
+}
+
+func (s ProvisioningStepRes) diagnose() error {
+	if s.Step != nil && s.Step.ErrorMessage != "" {
+		return fmt.Errorf("%s", s.Step.ErrorMessage)
+	}
+	if s.Step != nil && IsStepCompleted(s.Step.Status) {
+		for _, in := range s.Inputs {
+			if !in.Resolved {
+				return fmt.Errorf("unresolved input %s", in.String())
+			}
+		}
+	}
+
+	return nil
+}
+
+// GetObjectKind returns a schema object.
+func (ProvisioningStepRes) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a container copy.
+func (s ProvisioningStepRes) DeepCopyObject() runtime.Object {
+	return s
+}
@@ -0,0 +1,72 @@
+package render_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProvisioningStepRender(t *testing.T) {
+	uu := map[string]struct {
+		res render.ProvisioningStepRes
+		e   render.Fields
+	}{
+		"completed": {
+			res: render.ProvisioningStepRes{
+				Index: 1,
+				Phase: render.PreTasksPhase,
+				Name:  "network",
+				Task:  &render.ClusterDefinitionTask{Name: "network", TaskType: "Vnet"},
+				Step: &render.StepStatus{
+					Name:       "network",
+					Status:     "Completed",
+					Properties: map[string]string{"subnetId": "s1"},
+				},
+			},
+			e: render.Fields{"1", "PreTasks", "network", "Vnet", "Completed", "n/a", "", "subnetId=s1", ""},
+		},
+		"unresolved": {
+			res: render.ProvisioningStepRes{
+				Index: 2,
+				Phase: render.TasksPhase,
+				Name:  "aks",
+				Task:  &render.ClusterDefinitionTask{Name: "aks", TaskType: "Aks"},
+				Step:  &render.StepStatus{Name: "aks", Status: "Completed"},
+				Inputs: []render.TaskInput{
+					{Property: "subnet", Ref: render.ClusterDefinitionTaskRef{TaskName: "network", OutputProperty: "subnetId"}},
+				},
+			},
+			e: render.Fields{"2", "Tasks", "aks", "Aks", "Completed", "subnet<-network.subnetId=<none>", "", "", "unresolved input subnet<-network.subnetId=<none>"},
+		},
+		"pending": {
+			res: render.ProvisioningStepRes{
+				Index: 3,
+				Phase: render.PostTasksPhase,
+				Name:  "register",
+				Task:  &render.ClusterDefinitionTask{Name: "register", TaskType: "Register"},
+			},
+			e: render.Fields{"3", "PostTasks", "register", "Register", "<none>", "n/a", "", "", ""},
+		},
+		"failed": {
+			res: render.ProvisioningStepRes{
+				Index: 4,
+				Phase: render.NAValue,
+				Name:  "cleanup",
+				Step:  &render.StepStatus{Name: "cleanup", Type: "Cleanup", Status: "Failed", ErrorMessage: "boom"},
+			},
+			e: render.Fields{"4", "n/a", "cleanup", "Cleanup", "Failed", "n/a", "boom", "", "boom"},
+		},
+	}
+
+	var r render.ProvisioningStepRenderer
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			var row render.Row
+			assert.Nil(t, r.Render(u.res, "", &row))
+			assert.Equal(t, u.res.Name, row.ID)
+			assert.Equal(t, u.e, row.Fields)
+		})
+	}
+}
@@ -0,0 +1,150 @@
+package render
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/tcell/v2"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// A collection of per cluster rollout states.
+const (
+	RolloutConverged   = "Converged"
+	RolloutProgressing = "Progressing"
+	RolloutPending     = "Pending"
+)
+
+// RolloutRenderer renders an application rollout progress on a fleet cluster.
+type RolloutRenderer struct {
+	Base
+}
+
+// ColorerFunc colors a resource row.
+func (RolloutRenderer) ColorerFunc() ColorerFunc {
+	return func(ns string, h Header, re RowEvent) tcell.Color {
+		c := DefaultColorer(ns, h, re)
+		stateCol := h.IndexOf("STATE", true)
+		if stateCol == -1 {
+			return c
+		}
+		readyCol := h.IndexOf("READY", true)
+		switch strings.TrimSpace(re.Row.Fields[stateCol]) {
+		case RolloutConverged:
+			return c
+		case RolloutPending:
+			return CompletedColor
+		default:
+			if readyCol != -1 && strings.TrimSpace(re.Row.Fields[readyCol]) == string(metav1.ConditionFalse) {
+				return ErrColor
+			}
+			return PendingColor
+		}
+	}
+}
+
+// Header returns a header row.
+func (RolloutRenderer) Header(string) Header {
+	return Header{
+		HeaderColumn{Name: "CLUSTER"},
+		HeaderColumn{Name: "GENERATION"},
+		HeaderColumn{Name: "READY"},
+		HeaderColumn{Name: "STATE"},
+		HeaderColumn{Name: "REASON"},
+		HeaderColumn{Name: "MESSAGE", Wide: true},
+		HeaderColumn{Name: "MAX-UNAVAILABLE", Wide: true},
+		HeaderColumn{Name: "LAST-OBSERVED", Time: true},
+	}
+}
+
+// Render renders a cluster rollout progress to screen.
+func (RolloutRenderer) Render(o interface{}, ns string, r *Row) error {
+	res, ok := o.(RolloutRes)
+	if !ok {
+		return fmt.Errorf("Expected RolloutRes, but got %T", o)
+	}
+
+	ready, reason, message := MissingValue, "", ""
+	if c := apimeta.FindStatusCondition(res.Status.Conditions, "Ready"); c != nil {
+		ready, reason, message = string(c.Status), c.Reason, c.Message
+	}
+	r.ID = client.FQN(client.ClusterScope, res.Status.Cluster)
+	r.Fields = Fields{
+		res.Status.Cluster,
+		strconv.FormatInt(res.Status.ObservedGeneration, 10) + "/" + strconv.FormatInt(res.Generation, 10),
+		ready,
+		ClusterRolloutState(res.Generation, &res.Status),
+		NA(reason),
+		message,
+		res.Budget.String(),
+		toAge(res.Status.LastManifestStatusObservedTime),
+	}
+
+	return nil
+}
+
+// ClusterRolloutState returns a cluster rollout state for a given application generation.
+func ClusterRolloutState(generation int64, cs *ApplicationClusterStatus) string {
+	if cs.ObservedGeneration < generation {
+		return RolloutPending
+	}
+	if apimeta.IsStatusConditionTrue(cs.Conditions, "Ready") {
+		return RolloutConverged
+	}
+
+	return RolloutProgressing
+}
+
+// RolloutProgress returns the number of converged clusters over the application target clusters.
+func RolloutProgress(app *Application) (converged, total int) {
+	for i := range app.Status.Clusters {
+		if ClusterRolloutState(app.Generation, &app.Status.Clusters[i]) == RolloutConverged {
+			converged++
+		}
+	}
+
+	return converged, len(app.Status.Clusters)
+}
+
+// RolloutRes represents an application rollout progress on a fleet cluster.
+type RolloutRes struct {
+	Generation int64
+	Status     ApplicationClusterStatus
+	Budget     *RolloutBudget
+}
+
+// RolloutBudget tracks the most restrictive disruption budget applying to an application rollout.
+type RolloutBudget struct {
+	// Name tracks the fleet disruption budget name.
+	Name string
+
+	// MaxUnavailable tracks the budget max unavailable clusters spec.
+	MaxUnavailable string
+
+	// Allowed tracks the number of application clusters allowed to be unavailable.
+	Allowed int
+}
+
+// String returns the allowed unavailable clusters along with the budget they derive from.
+func (b *RolloutBudget) String() string {
+	if b == nil {
+		return NAValue
+	}
+
+	return fmt.Sprintf("%d (%s %s)", b.Allowed, b.Name, b.MaxUnavailable)
+}
+
+// GetObjectKind returns a schema object.
+func (RolloutRes) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a container copy.
+func (r RolloutRes) DeepCopyObject() runtime.Object {
+	return r
+}
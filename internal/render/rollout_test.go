@@ -0,0 +1,74 @@
+package render_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestRolloutRender(t *testing.T) {
+	uu := map[string]struct {
+		res render.RolloutRes
+		e   render.Fields
+	}{
+		"converged": {
+			res: render.RolloutRes{Generation: 2, Status: makeRolloutStatus("c1", 2, metav1.ConditionTrue)},
+			e:   render.Fields{"c1", "2/2", "True", "Converged", "Applied", "", "n/a", "<unknown>"},
+		},
+		"progressing": {
+			res: render.RolloutRes{
+				Generation: 2,
+				Status:     makeRolloutStatus("c2", 2, metav1.ConditionFalse),
+				Budget:     &render.RolloutBudget{Name: "east", MaxUnavailable: "50%", Allowed: 2},
+			},
+			e: render.Fields{"c2", "2/2", "False", "Progressing", "Applied", "", "2 (east 50%)", "<unknown>"},
+		},
+		"pending": {
+			res: render.RolloutRes{Generation: 3, Status: makeRolloutStatus("c3", 2, metav1.ConditionTrue)},
+			e:   render.Fields{"c3", "2/3", "True", "Pending", "Applied", "", "n/a", "<unknown>"},
+		},
+		"no-condition": {
+			res: render.RolloutRes{Generation: 1, Status: render.ApplicationClusterStatus{Cluster: "c4", ObservedGeneration: 1}},
+			e:   render.Fields{"c4", "1/1", "<none>", "Progressing", "n/a", "", "n/a", "<unknown>"},
+		},
+	}
+
+	var r render.RolloutRenderer
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			var row render.Row
+			assert.Nil(t, r.Render(u.res, "", &row))
+			assert.Equal(t, "-/"+u.res.Status.Cluster, row.ID)
+			assert.Equal(t, u.e, row.Fields)
+		})
+	}
+}
+
+func TestRolloutProgress(t *testing.T) {
+	var app render.Application
+	app.Generation = 2
+	app.Status.Clusters = []render.ApplicationClusterStatus{
+		makeRolloutStatus("c1", 2, metav1.ConditionTrue),
+		makeRolloutStatus("c2", 2, metav1.ConditionFalse),
+		makeRolloutStatus("c3", 1, metav1.ConditionTrue),
+	}
+
+	converged, total := render.RolloutProgress(&app)
+	assert.Equal(t, 1, converged)
+	assert.Equal(t, 3, total)
+}
+
+// Helpers...
+
+func makeRolloutStatus(cluster string, gen int64, ready metav1.ConditionStatus) render.ApplicationClusterStatus {
+	return render.ApplicationClusterStatus{
+		Cluster:            cluster,
+		ObservedGeneration: gen,
+		Conditions: []metav1.Condition{
+			{Type: "Ready", Status: ready, Reason: "Applied"},
+		},
+	}
+}
@@ -49,7 +49,7 @@ func (s StatefulSet) Render(o interface{}, ns string, r *Row) error {
 		sts.Name,
 		strconv.Itoa(int(sts.Status.ReadyReplicas)) + "/" + strconv.Itoa(int(sts.Status.Replicas)),
 		asSelector(sts.Spec.Selector),
-		na(sts.Spec.ServiceName),
+		NA(sts.Spec.ServiceName),
 		podContainerNames(sts.Spec.Template.Spec, true),
 		podImageNames(sts.Spec.Template.Spec, true),
 		mapToStr(sts.Labels),
@@ -15,6 +15,7 @@ type Crumbs struct {
 
 	styles *config.Styles
 	stack  *model.Stack
+	home   string
 }
 
 // NewCrumbs returns a new breadcrumb view.
@@ -40,6 +41,12 @@ func (c *Crumbs) StylesChanged(s *config.Styles) {
 	c.refresh(c.stack.Flatten())
 }
 
+// SetHome sets a leading crumb pointing back to a home context.
+func (c *Crumbs) SetHome(home string) {
+	c.home = home
+	c.refresh(c.stack.Flatten())
+}
+
 // StackPushed indicates a new item was added.
 func (c *Crumbs) StackPushed(comp model.Component) {
 	c.stack.Push(comp)
@@ -58,6 +65,12 @@ func (c *Crumbs) StackTop(top model.Component) {}
 // Refresh updates view with new crumbs.
 func (c *Crumbs) refresh(crumbs []string) {
 	c.Clear()
+	if c.home != "" {
+		fmt.Fprintf(c, "[%s:%s:b] <hub:%s> [-:%s:-] ",
+			c.styles.Frame().Crumb.FgColor,
+			c.styles.Frame().Crumb.BgColor, c.home,
+			c.styles.Body().BgColor)
+	}
 	last, bgColor := len(crumbs)-1, c.styles.Frame().Crumb.BgColor
 	for i, crumb := range crumbs {
 		if i == last {
@@ -0,0 +1,59 @@
+package dialog
+
+import (
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tview"
+)
+
+const selectionLabel = "Select:"
+
+type selectFunc func(index int)
+
+// ShowSelection pops a dialog to pick one of the given options.
+func ShowSelection(styles config.Dialog, pages *ui.Pages, title, msg string, options []string, ack selectFunc, cancel cancelFunc) {
+	var selected int
+	f := tview.NewForm()
+	f.SetItemPadding(0)
+	f.SetButtonsAlign(tview.AlignCenter).
+		SetButtonBackgroundColor(styles.ButtonBgColor.Color()).
+		SetButtonTextColor(styles.ButtonFgColor.Color()).
+		SetLabelColor(styles.LabelFgColor.Color()).
+		SetFieldTextColor(styles.FieldFgColor.Color())
+	f.AddDropDown(selectionLabel, options, 0, func(_ string, optionIndex int) {
+		selected = optionIndex
+	})
+	field := f.GetFormItemByLabel(selectionLabel).(*tview.DropDown)
+	field.SetListStyles(
+		styles.FgColor.Color(), styles.BgColor.Color(),
+		styles.ButtonFocusFgColor.Color(), styles.ButtonFocusBgColor.Color(),
+	)
+	f.AddButton("Cancel", func() {
+		dismiss(pages)
+		cancel()
+	})
+	f.AddButton("OK", func() {
+		ack(selected)
+		dismiss(pages)
+		cancel()
+	})
+	for i := 0; i < 2; i++ {
+		b := f.GetButton(i)
+		if b == nil {
+			continue
+		}
+		b.SetBackgroundColorActivated(styles.ButtonFocusBgColor.Color())
+		b.SetLabelColorActivated(styles.ButtonFocusFgColor.Color())
+	}
+	f.SetFocus(0)
+
+	modal := tview.NewModalForm("<"+title+">", f)
+	modal.SetText(msg)
+	modal.SetTextColor(styles.FgColor.Color())
+	modal.SetDoneFunc(func(int, string) {
+		dismiss(pages)
+		cancel()
+	})
+	pages.AddPage(dialogKey, modal, false, false)
+	pages.ShowPage(dialogKey)
+}
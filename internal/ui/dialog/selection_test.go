@@ -0,0 +1,24 @@
+package dialog
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tview"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSelectionDialog(t *testing.T) {
+	a := tview.NewApplication()
+	p := ui.NewPages()
+	a.SetRoot(p, false)
+
+	ShowSelection(config.Dialog{}, p, "Blee", "Yo", []string{"c1", "c2"}, func(int) {}, func() {})
+
+	d := p.GetPrimitive(dialogKey).(*tview.ModalForm)
+	assert.NotNil(t, d)
+
+	dismiss(p)
+	assert.Nil(t, p.GetPrimitive(dialogKey))
+}
@@ -46,6 +46,7 @@ type Table struct {
 	wide        bool
 	toast       bool
 	hasMetrics  bool
+	colFilter   *ColumnFilter
 }
 
 // NewTable returns a new table view.
@@ -111,6 +112,24 @@ func (t *Table) ToggleToast() {
 	t.Refresh()
 }
 
+// SetColumnFilter hides the rows whose column value is excluded by the filter.
+// The table switches to wide mode so a filtered wide column stays visible.
+func (t *Table) SetColumnFilter(f *ColumnFilter) {
+	t.colFilter, t.wide = f, true
+	t.Refresh()
+}
+
+// ClearColumnFilter clears out the column filter and reports whether one was set.
+func (t *Table) ClearColumnFilter() bool {
+	if t.colFilter == nil {
+		return false
+	}
+	t.colFilter = nil
+	t.Refresh()
+
+	return true
+}
+
 // ToggleWide toggles wide col display.
 func (t *Table) ToggleWide() {
 	t.wide = !t.wide
@@ -380,6 +399,9 @@ func (t *Table) filtered(data *render.TableData) *render.TableData {
 	if t.toast {
 		filtered = filterToast(data)
 	}
+	if t.colFilter != nil {
+		filtered = t.colFilter.filter(filtered)
+	}
 	if t.cmdBuff.Empty() || IsLabelSelector(t.cmdBuff.GetText()) {
 		return filtered
 	}
@@ -448,6 +470,9 @@ func (t *Table) styleTitle() string {
 	}
 
 	buff := t.cmdBuff.GetText()
+	if buff == "" && t.colFilter != nil {
+		buff = t.colFilter.String()
+	}
 	if buff == "" {
 		return title
 	}
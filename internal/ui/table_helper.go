@@ -148,6 +148,44 @@ func filterToast(data *render.TableData) *render.TableData {
 	return &toast
 }
 
+// ColumnFilter hides the rows whose column value is one of the excluded values.
+type ColumnFilter struct {
+	// Column tracks the filtered column name.
+	Column string
+
+	// Excluded tracks the column values to hide.
+	Excluded []string
+}
+
+// String returns the filter expression.
+func (f *ColumnFilter) String() string {
+	return f.Column + "!=" + strings.Join(f.Excluded, ",")
+}
+
+func (f *ColumnFilter) filter(data *render.TableData) *render.TableData {
+	col := data.Header.IndexOf(f.Column, true)
+	if col == -1 {
+		return data
+	}
+
+	filtered := render.TableData{
+		Header:    data.Header,
+		RowEvents: make(render.RowEvents, 0, len(data.RowEvents)),
+		Namespace: data.Namespace,
+	}
+	excluded := make(map[string]struct{}, len(f.Excluded))
+	for _, v := range f.Excluded {
+		excluded[v] = struct{}{}
+	}
+	for _, re := range data.RowEvents {
+		if _, ok := excluded[strings.TrimSpace(re.Row.Fields[col])]; !ok {
+			filtered.RowEvents = append(filtered.RowEvents, re)
+		}
+	}
+
+	return &filtered
+}
+
 func rxFilter(q string, inverse bool, data *render.TableData) (*render.TableData, error) {
 	if inverse {
 		q = q[1:]
@@ -3,6 +3,7 @@ package ui
 import (
 	"testing"
 
+	"github.com/derailed/k9s/internal/render"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -40,3 +41,59 @@ func TestTrimLabelSelector(t *testing.T) {
 		})
 	}
 }
+
+func TestColumnFilter(t *testing.T) {
+	uu := map[string]struct {
+		f *ColumnFilter
+		e []string
+	}{
+		"none": {
+			f: &ColumnFilter{Column: "C", Excluded: []string{"bozo"}},
+			e: []string{"r1", "r2"},
+		},
+		"excluded": {
+			f: &ColumnFilter{Column: "C", Excluded: []string{"bozo", "fred"}},
+			e: []string{"r2"},
+		},
+		"all": {
+			f: &ColumnFilter{Column: "B", Excluded: []string{"duh"}},
+			e: []string{},
+		},
+		"missing-col": {
+			f: &ColumnFilter{Column: "Z", Excluded: []string{"fred"}},
+			e: []string{"r1", "r2"},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			data := u.f.filter(makeFilterData())
+			ids := make([]string, 0, len(data.RowEvents))
+			for _, re := range data.RowEvents {
+				ids = append(ids, re.Row.ID)
+			}
+			assert.Equal(t, u.e, ids)
+		})
+	}
+}
+
+func TestColumnFilterString(t *testing.T) {
+	f := ColumnFilter{Column: "STATE", Excluded: []string{"Available", "Completed"}}
+
+	assert.Equal(t, "STATE!=Available,Completed", f.String())
+}
+
+func makeFilterData() *render.TableData {
+	return &render.TableData{
+		Header: render.Header{
+			render.HeaderColumn{Name: "A"},
+			render.HeaderColumn{Name: "B"},
+			render.HeaderColumn{Name: "C"},
+		},
+		RowEvents: render.RowEvents{
+			render.RowEvent{Row: render.Row{ID: "r1", Fields: render.Fields{"blee", "duh", "fred"}}},
+			render.RowEvent{Row: render.Row{ID: "r2", Fields: render.Fields{"blee", "duh", "zorg"}}},
+		},
+	}
+}
@@ -56,6 +56,23 @@ func TestTableSelection(t *testing.T) {
 	assert.Equal(t, 1, v.GetSelectedRowIndex())
 }
 
+func TestTableColumnFilter(t *testing.T) {
+	v := ui.NewTable(client.NewGVR("fred"))
+	v.Init(makeContext())
+	m := &mockModel{}
+	v.SetModel(m)
+	v.Update(m.Peek(), false)
+	assert.Equal(t, 3, v.GetRowCount())
+	assert.False(t, v.ClearColumnFilter())
+
+	v.SetColumnFilter(&ui.ColumnFilter{Column: "C", Excluded: []string{"fred"}})
+	assert.Equal(t, 2, v.GetRowCount())
+	assert.Equal(t, "r2", v.GetSelectedItem())
+
+	assert.True(t, v.ClearColumnFilter())
+	assert.Equal(t, 3, v.GetRowCount())
+}
+
 // ----------------------------------------------------------------------------
 // Helpers...
 
@@ -17,7 +17,9 @@ import (
 	"github.com/derailed/k9s/internal"
 	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/dao"
 	"github.com/derailed/k9s/internal/model"
+	"github.com/derailed/k9s/internal/render"
 	"github.com/derailed/k9s/internal/ui"
 	"github.com/derailed/k9s/internal/ui/dialog"
 	"github.com/derailed/k9s/internal/watch"
@@ -52,6 +54,7 @@ type App struct {
 	showHeader    bool
 	showLogo      bool
 	showCrumbs    bool
+	fleetHub      *fleetHub
 }
 
 // NewApp returns a K9s app instance.
@@ -113,6 +116,7 @@ func (a *App) Init(version string, rate int) error {
 		return err
 	}
 	a.CmdBuff().SetSuggestionFn(a.suggestCommand())
+	a.initNotifications()
 
 	a.layout(ctx)
 	a.initSignals()
@@ -412,6 +416,7 @@ func (a *App) switchContext(name string) error {
 		if e := a.command.Reset(true); e != nil {
 			return e
 		}
+		dao.FleetNotifier.Watch(a.factory)
 		if a.Config.ActiveView() == "" || isContextCmd(a.Config.ActiveView()) {
 			a.Config.SetActiveView("pod")
 		}
@@ -438,8 +443,33 @@ func (a *App) switchContext(name string) error {
 	return nil
 }
 
+// initNotifications loads the fleet notification rules and watches the fleet resources.
+func (a *App) initNotifications() {
+	nn := config.NewFleetNotifications()
+	if err := nn.Load(); err != nil && !errors.Is(err, os.ErrNotExist) {
+		log.Warn().Err(err).Msg("Unable to load fleet notifications. Using defaults...")
+		nn = config.NewFleetNotifications()
+	}
+	dao.FleetNotifier.Configure(nn)
+	dao.FleetNotifier.AddListener(a)
+	dao.FleetNotifier.Watch(a.factory)
+}
+
+// FleetNotified flashes a fleet notification.
+func (a *App) FleetNotified(n render.FleetNotification) {
+	switch n.Level {
+	case render.NotifyError:
+		a.Flash().Err(errors.New(n.Message()))
+	case render.NotifyWarn:
+		a.Flash().Warn(n.Message())
+	default:
+		a.Flash().Info(n.Message())
+	}
+}
+
 func (a *App) initFactory(ns string) {
 	a.factory.Terminate()
+	dao.ResetFleetCache()
 	a.factory.Start(ns)
 }
 
@@ -455,6 +485,7 @@ func (a *App) BailOut() {
 		log.Error().Err(err).Msgf("nuking k9s shell pod")
 	}
 	a.factory.Terminate()
+	dao.ClearMemberKubeConfigs()
 	a.App.BailOut()
 }
 
@@ -2,10 +2,13 @@ package view
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/derailed/k9s/internal"
 	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
 	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/k9s/internal/ui/dialog"
 	"github.com/derailed/tcell/v2"
 )
 
@@ -28,17 +31,31 @@ func NewApplication(gvr client.GVR) ResourceViewer {
 	return &c
 }
 
+func (c *Application) bindDangerousKeys(aa ui.KeyActions) {
+	aa.Add(ui.KeyActions{
+		ui.KeyP: ui.NewKeyAction("Pause", c.togglePauseCmd(true), true),
+		ui.KeyU: ui.NewKeyAction("Resume", c.togglePauseCmd(false), true),
+		ui.KeyR: ui.NewKeyAction("Rollback LKG", c.rollbackCmd, true),
+	})
+}
+
 func (c *Application) bindKeys(aa ui.KeyActions) {
+	if !c.App().Config.K9s.IsReadOnly() {
+		c.bindDangerousKeys(aa)
+	}
+
 	aa.Add(ui.KeyActions{
-		ui.KeyS: ui.NewKeyAction("Show Status", c.showApplicationStatus, true),
+		ui.KeyS:      ui.NewKeyAction("Show Status", c.showApplicationStatus, true),
+		ui.KeyO:      ui.NewKeyAction("Rollout", c.showRollout, true),
+		ui.KeyShiftD: ui.NewKeyAction("Drift", c.showDrift, true),
+		ui.KeyV:      ui.NewKeyAction("Placement Preview", c.showPlacement, true),
+		ui.KeyX:      ui.NewKeyAction("Snapshot", fleetSnapshotCmd(c.App()), true),
 	})
 	aa.Add(resourceSorters(c.GetTable()))
 }
 
 func (c *Application) showManifests(app *App, model ui.Tabular, gvr, path string) {
-	co := NewManifest(client.NewGVR("manifests"))
-	co.SetContextFn(c.applicationContext(path))
-	if err := app.inject(co, false); err != nil {
+	if err := app.inject(NewApplicationManifest(path), false); err != nil {
 		app.Flash().Err(err)
 	}
 }
@@ -59,6 +76,123 @@ func (c *Application) showApplicationStatus(evt *tcell.EventKey) *tcell.EventKey
 	return nil
 }
 
+func (c *Application) showDrift(evt *tcell.EventKey) *tcell.EventKey {
+	path := c.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+
+	if err := c.App().inject(NewDrift(path), false); err != nil {
+		c.App().Flash().Err(err)
+	}
+
+	return nil
+}
+
+func (c *Application) showPlacement(evt *tcell.EventKey) *tcell.EventKey {
+	path := c.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+
+	if err := c.App().inject(NewPlacement(path), false); err != nil {
+		c.App().Flash().Err(err)
+	}
+
+	return nil
+}
+
+func (c *Application) showRollout(evt *tcell.EventKey) *tcell.EventKey {
+	path := c.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+
+	if err := c.App().inject(NewRollout(path), false); err != nil {
+		c.App().Flash().Err(err)
+	}
+
+	return nil
+}
+
+func (c *Application) togglePauseCmd(pause bool) func(evt *tcell.EventKey) *tcell.EventKey {
+	return func(evt *tcell.EventKey) *tcell.EventKey {
+		path := c.GetTable().GetSelectedItem()
+		if path == "" {
+			return evt
+		}
+
+		title, msg := "Confirm ", ""
+		if pause {
+			title, msg = title+"Pause", "Pause rollout of "
+		} else {
+			title, msg = title+"Resume", "Resume rollout of "
+		}
+		msg += path + "?"
+		dialog.ShowConfirm(c.App().Styles.Dialog(), c.App().Content.Pages, title, msg, func() {
+			m, err := c.maintainer()
+			if err != nil {
+				c.App().Flash().Err(err)
+				return
+			}
+			if err := m.TogglePause(path, pause); err != nil {
+				c.App().Flash().Err(err)
+				return
+			}
+			c.Refresh()
+		}, func() {})
+
+		return nil
+	}
+}
+
+func (c *Application) rollbackCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := c.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+
+	app, err := dao.FetchApplication(c.App().factory, path)
+	if err != nil {
+		c.App().Flash().Err(err)
+		return nil
+	}
+	lkg, err := dao.RollbackVersion(app)
+	if err != nil {
+		c.App().Flash().Err(err)
+		return nil
+	}
+	msg := fmt.Sprintf("Rollback %s from version %s to last known good %s?", path, app.Spec.Version, lkg)
+	dialog.ShowConfirm(c.App().Styles.Dialog(), c.App().Content.Pages, "Confirm Rollback", msg, func() {
+		m, err := c.maintainer()
+		if err != nil {
+			c.App().Flash().Err(err)
+			return
+		}
+		if err := m.RollbackLKG(path); err != nil {
+			c.App().Flash().Err(err)
+			return
+		}
+		c.App().Flash().Infof("Rolling back %s to %s", path, lkg)
+		c.Refresh()
+	}, func() {})
+
+	return nil
+}
+
+func (c *Application) maintainer() (dao.RolloutMaintainer, error) {
+	res, err := dao.AccessorFor(c.App().factory, c.GVR())
+	if err != nil {
+		return nil, err
+	}
+	m, ok := res.(dao.RolloutMaintainer)
+	if !ok {
+		return nil, fmt.Errorf("expecting a rollout maintainer for %q", c.GVR())
+	}
+
+	return m, nil
+}
+
 func (c *Application) applicationContext(path string) ContextFunc {
 	return func(ctx context.Context) context.Context {
 		return context.WithValue(ctx, internal.KeyPath, path)
@@ -5,6 +5,7 @@ import (
 
 	"github.com/derailed/k9s/internal"
 	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/ui"
 	"github.com/derailed/tcell/v2"
 )
 
@@ -20,6 +21,7 @@ func NewApplicationStatus(gvr client.GVR) ResourceViewer {
 	}
 	c.GetTable().SetBorderFocusColor(tcell.ColorMediumSpringGreen)
 	c.GetTable().SetSelectedStyle(tcell.StyleDefault.Foreground(tcell.ColorWhite).Background(tcell.ColorMediumSpringGreen).Attributes(tcell.AttrNone))
+	c.GetTable().SetEnterFn(c.showClusterManifests)
 	c.SetContextFn(c.applicationContext)
 
 	return &c
@@ -30,5 +32,13 @@ func (c *ApplicationStatus) applicationContext(ctx context.Context) context.Cont
 	return context.WithValue(ctx, internal.KeyPath, key)
 }
 
+func (c *ApplicationStatus) showClusterManifests(app *App, _ ui.Tabular, _, path string) {
+	_, cluster := client.Namespaced(path)
+	v := NewClusterManifest(c.GetTable().Path, cluster)
+	if err := app.inject(v, false); err != nil {
+		app.Flash().Err(err)
+	}
+}
+
 // Name returns the component name.
 func (c *ApplicationStatus) Name() string { return "status" }
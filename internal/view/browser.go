@@ -278,9 +278,13 @@ func (b *Browser) helpCmd(evt *tcell.EventKey) *tcell.EventKey {
 func (b *Browser) resetCmd(evt *tcell.EventKey) *tcell.EventKey {
 	if !b.CmdBuff().InCmdMode() {
 		b.CmdBuff().ClearText(false)
+		if b.GetTable().ClearColumnFilter() {
+			return nil
+		}
 		return b.App().PrevCmd(evt)
 	}
 
+	b.GetTable().ClearColumnFilter()
 	b.CmdBuff().Reset()
 	if ui.IsLabelSelector(b.CmdBuff().GetText()) {
 		b.Start()
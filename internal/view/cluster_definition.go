@@ -0,0 +1,86 @@
+package view
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tcell/v2"
+)
+
+// ClusterDefinition represents a fleet cluster definition view.
+type ClusterDefinition struct {
+	ResourceViewer
+}
+
+// NewClusterDefinition returns a new cluster definition view.
+func NewClusterDefinition(gvr client.GVR) ResourceViewer {
+	c := ClusterDefinition{
+		ResourceViewer: NewBrowser(gvr),
+	}
+	c.GetTable().SetBorderFocusColor(tcell.ColorMediumSpringGreen)
+	c.GetTable().SetSelectedStyle(tcell.StyleDefault.Foreground(tcell.ColorWhite).Background(tcell.ColorMediumSpringGreen).Attributes(tcell.AttrNone))
+	c.GetTable().SetEnterFn(showDefinitionTasks)
+	c.AddBindKeysFn(c.bindKeys)
+
+	return &c
+}
+
+func (c *ClusterDefinition) bindKeys(aa ui.KeyActions) {
+	aa.Add(ui.KeyActions{
+		ui.KeyShiftD: ui.NewKeyAction("Sort Dangling", c.GetTable().SortColCmd("DANGLING", false), false),
+	})
+}
+
+// showDefinitionTasks shows a cluster definition tasks tree grouped by phase.
+func showDefinitionTasks(app *App, _ ui.Tabular, _, path string) {
+	x := NewXray(client.NewGVR(dao.ClusterDefinitionGVR))
+	x.SetContextFn(func(ctx context.Context) context.Context {
+		return context.WithValue(ctx, internal.KeyPath, path)
+	})
+	if err := app.inject(x, false); err != nil {
+		app.Flash().Err(err)
+	}
+}
+
+// ClusterProvisioner represents a fleet cluster provisioner view.
+type ClusterProvisioner struct {
+	ResourceViewer
+}
+
+// NewClusterProvisioner returns a new cluster provisioner view.
+func NewClusterProvisioner(gvr client.GVR) ResourceViewer {
+	c := ClusterProvisioner{
+		ResourceViewer: NewBrowser(gvr),
+	}
+	c.GetTable().SetBorderFocusColor(tcell.ColorMediumSpringGreen)
+	c.GetTable().SetSelectedStyle(tcell.StyleDefault.Foreground(tcell.ColorWhite).Background(tcell.ColorMediumSpringGreen).Attributes(tcell.AttrNone))
+	c.GetTable().SetEnterFn(c.showDefaultDefinition)
+	c.AddBindKeysFn(c.bindKeys)
+
+	return &c
+}
+
+func (c *ClusterProvisioner) bindKeys(aa ui.KeyActions) {
+	aa.Add(ui.KeyActions{
+		ui.KeyShiftP: ui.NewKeyAction("Sort Priority", c.GetTable().SortColCmd("PRIORITY", false), false),
+	})
+}
+
+func (c *ClusterProvisioner) showDefaultDefinition(app *App, _ ui.Tabular, _, path string) {
+	row, ok := c.GetTable().GetSelectedRow(path)
+	if !ok {
+		app.Flash().Errf("unable to locate provisioner %s", path)
+		return
+	}
+	idx := c.GetTable().GetModel().Peek().Header.IndexOf("DEFAULT-DEFINITION", true)
+	if idx == -1 || row.Fields[idx] == render.NAValue {
+		app.Flash().Err(fmt.Errorf("no default definition for provisioner %s", path))
+		return
+	}
+	showDefinitionTasks(app, nil, "", client.FQN(client.ClusterScope, row.Fields[idx]))
+}
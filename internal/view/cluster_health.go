@@ -0,0 +1,77 @@
+package view
+
+import (
+	"context"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tcell/v2"
+)
+
+// ClusterHealth represents a fleet cluster health policies or reports view.
+type ClusterHealth struct {
+	ResourceViewer
+}
+
+// NewClusterHealth returns a new cluster health view.
+func NewClusterHealth(gvr client.GVR) ResourceViewer {
+	c := ClusterHealth{
+		ResourceViewer: NewBrowser(gvr),
+	}
+	c.GetTable().SetBorderFocusColor(tcell.ColorMediumSpringGreen)
+	c.GetTable().SetSelectedStyle(tcell.StyleDefault.Foreground(tcell.ColorWhite).Background(tcell.ColorMediumSpringGreen).Attributes(tcell.AttrNone))
+	c.GetTable().SetEnterFn(showHealthPolicies)
+	c.AddBindKeysFn(c.bindKeys)
+
+	return &c
+}
+
+func (c *ClusterHealth) bindKeys(aa ui.KeyActions) {
+	aa.Add(ui.KeyActions{
+		ui.KeyShiftS: ui.NewKeyAction("Sort Status", c.GetTable().SortColCmd(statusCol, true), false),
+		ui.KeyShiftU: ui.NewKeyAction("Sort Unhealthy", c.GetTable().SortColCmd("UNHEALTHY", false), false),
+	})
+}
+
+func showHealthPolicies(app *App, _ ui.Tabular, gvr, path string) {
+	v := NewHealthPolicy(client.NewGVR("healthpolicies"))
+	v.SetContextFn(healthPolicyCtx(gvr, path))
+	if err := app.inject(v, false); err != nil {
+		app.Flash().Err(err)
+	}
+}
+
+func healthPolicyCtx(gvr, path string) ContextFunc {
+	return func(ctx context.Context) context.Context {
+		ctx = context.WithValue(ctx, internal.KeyPath, path)
+		return context.WithValue(ctx, internal.KeyGVR, gvr)
+	}
+}
+
+// HealthPolicy represents the health policies of a cluster health policy or report.
+type HealthPolicy struct {
+	ResourceViewer
+}
+
+// NewHealthPolicy returns a new health policies view.
+func NewHealthPolicy(gvr client.GVR) ResourceViewer {
+	h := HealthPolicy{
+		ResourceViewer: NewBrowser(gvr),
+	}
+	h.GetTable().SetBorderFocusColor(tcell.ColorMediumSpringGreen)
+	h.GetTable().SetSelectedStyle(tcell.StyleDefault.Foreground(tcell.ColorWhite).Background(tcell.ColorMediumSpringGreen).Attributes(tcell.AttrNone))
+	h.GetTable().SetEnterFn(blankEnterFn)
+	h.AddBindKeysFn(h.bindKeys)
+
+	return &h
+}
+
+func (h *HealthPolicy) bindKeys(aa ui.KeyActions) {
+	aa.Delete(ui.KeyShiftA, ui.KeyShiftN, tcell.KeyCtrlSpace, ui.KeySpace)
+	aa.Add(ui.KeyActions{
+		ui.KeyShiftN: ui.NewKeyAction("Sort Policy", h.GetTable().SortColCmd("POLICY", true), false),
+		ui.KeyShiftS: ui.NewKeyAction("Sort Status", h.GetTable().SortColCmd(statusCol, true), false),
+		ui.KeyShiftI: ui.NewKeyAction("Sort Impact", h.GetTable().SortColCmd("IMPACT", true), false),
+	})
+}
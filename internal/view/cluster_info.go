@@ -2,6 +2,7 @@ package view
 
 import (
 	"fmt"
+	"path/filepath"
 
 	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/config"
@@ -102,7 +103,7 @@ func (c *ClusterInfo) ClusterInfoChanged(prev, curr model.ClusterMeta) {
 	c.app.QueueUpdateDraw(func() {
 		c.Clear()
 		c.layout()
-		row := c.setCell(0, curr.Context)
+		row := c.setCell(0, c.contextInfo(curr.Context))
 		row = c.setCell(row, curr.Cluster)
 		row = c.setCell(row, curr.User)
 		if curr.K9sLatest != "" {
@@ -123,6 +124,16 @@ func (c *ClusterInfo) ClusterInfoChanged(prev, curr model.ClusterMeta) {
 	})
 }
 
+// contextInfo flags the context when browsing an offline snapshot.
+func (c *ClusterInfo) contextInfo(context string) string {
+	snap := c.app.Config.K9s.Snapshot()
+	if snap == "" {
+		return context
+	}
+
+	return fmt.Sprintf("[red::b]SNAPSHOT[-::-] %s", filepath.Base(snap))
+}
+
 const defconFmt = "%s %s level!"
 
 func (c *ClusterInfo) setDefCon(cpu, mem int) {
@@ -0,0 +1,49 @@
+package view
+
+import (
+	"context"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tcell/v2"
+)
+
+// ClusterManifest represents an application manifests view on a given fleet cluster.
+type ClusterManifest struct {
+	ResourceViewer
+
+	app, cluster string
+}
+
+// NewClusterManifest returns a new cluster manifests view.
+func NewClusterManifest(app, cluster string) ResourceViewer {
+	c := ClusterManifest{
+		ResourceViewer: NewBrowser(client.NewGVR("clusterManifests")),
+		app:            app,
+		cluster:        cluster,
+	}
+	c.GetTable().SetBorderFocusColor(tcell.ColorMediumSpringGreen)
+	c.GetTable().SetSelectedStyle(tcell.StyleDefault.Foreground(tcell.ColorWhite).Background(tcell.ColorMediumSpringGreen).Attributes(tcell.AttrNone))
+	c.GetTable().SetEnterFn(blankEnterFn)
+	c.SetContextFn(c.clusterContext)
+	c.AddBindKeysFn(c.bindKeys)
+
+	return &c
+}
+
+// Name returns the component name.
+func (c *ClusterManifest) Name() string { return "manifests@" + c.cluster }
+
+func (c *ClusterManifest) clusterContext(ctx context.Context) context.Context {
+	ctx = context.WithValue(ctx, internal.KeyPath, c.app)
+	return context.WithValue(ctx, internal.KeyCluster, c.cluster)
+}
+
+func (c *ClusterManifest) bindKeys(aa ui.KeyActions) {
+	aa.Delete(ui.KeyShiftA, tcell.KeyCtrlSpace, ui.KeySpace)
+	aa.Add(ui.KeyActions{
+		ui.KeyShiftK: ui.NewKeyAction("Sort Kind", c.GetTable().SortColCmd("KIND", true), false),
+		ui.KeyShiftR: ui.NewKeyAction("Sort Ready", c.GetTable().SortColCmd("READY", true), false),
+	})
+}
@@ -70,6 +70,7 @@ func allowedXRay(gvr client.GVR) bool {
 		"apps/v1/daemonsets",
 		"apps/v1/statefulsets",
 		"apps/v1/replicasets",
+		"apis.clusterfleet.io/v1alpha1/applications",
 	}
 	for _, g := range gg {
 		if g == gvr.String() {
@@ -189,6 +190,11 @@ func (c *Command) specialCmd(cmd, path string) bool {
 	case "a", "alias":
 		c.app.aliasCmd(nil)
 		return true
+	case "hub":
+		if err := c.app.hubCmd(); err != nil {
+			c.app.Flash().Err(err)
+		}
+		return true
 	case "x", "xray":
 		if err := c.xrayCmd(cmd); err != nil {
 			c.app.Flash().Err(err)
@@ -109,6 +109,15 @@ func (c *Context) useCtx(app *App, model ui.Tabular, gvr, path string) {
 }
 
 func useContext(app *App, name string) error {
+	if app.fleetHub != nil {
+		return app.restoreHub(name)
+	}
+
+	return activateContext(app, name)
+}
+
+// activateContext switches the connection to a new context and reloads the app.
+func activateContext(app *App, name string) error {
 	if app.Content.Top() != nil {
 		app.Content.Top().Stop()
 	}
@@ -124,6 +133,6 @@ func useContext(app *App, name string) error {
 		log.Error().Err(err).Msgf("Context switch failed")
 		return err
 	}
-	
+
 	return app.switchContext(name)
 }
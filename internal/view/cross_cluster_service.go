@@ -0,0 +1,106 @@
+package view
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tcell/v2"
+)
+
+// CrossClusterService represents a fleet cross cluster service view.
+type CrossClusterService struct {
+	ResourceViewer
+}
+
+// NewCrossClusterService returns a new cross cluster service view.
+func NewCrossClusterService(gvr client.GVR) ResourceViewer {
+	c := CrossClusterService{
+		ResourceViewer: NewBrowser(gvr),
+	}
+	c.GetTable().SetBorderFocusColor(tcell.ColorMediumSpringGreen)
+	c.GetTable().SetSelectedStyle(tcell.StyleDefault.Foreground(tcell.ColorWhite).Background(tcell.ColorMediumSpringGreen).Attributes(tcell.AttrNone))
+	c.GetTable().SetEnterFn(showServiceMembers)
+
+	return &c
+}
+
+// CrossClusterShard represents a fleet cross cluster shard view.
+type CrossClusterShard struct {
+	ResourceViewer
+}
+
+// NewCrossClusterShard returns a new cross cluster shard view.
+func NewCrossClusterShard(gvr client.GVR) ResourceViewer {
+	c := CrossClusterShard{
+		ResourceViewer: NewBrowser(gvr),
+	}
+	c.GetTable().SetBorderFocusColor(tcell.ColorMediumSpringGreen)
+	c.GetTable().SetSelectedStyle(tcell.StyleDefault.Foreground(tcell.ColorWhite).Background(tcell.ColorMediumSpringGreen).Attributes(tcell.AttrNone))
+	c.GetTable().SetEnterFn(c.showService)
+
+	return &c
+}
+
+func (c *CrossClusterShard) showService(app *App, _ ui.Tabular, _, path string) {
+	row, ok := c.GetTable().GetSelectedRow(path)
+	if !ok {
+		app.Flash().Errf("unable to locate shard %s", path)
+		return
+	}
+	idx := c.GetTable().GetModel().Peek().Header.IndexOf("SERVICE", true)
+	if idx == -1 || row.Fields[idx] == render.NAValue {
+		app.Flash().Err(fmt.Errorf("no service for shard %s", path))
+		return
+	}
+	ns, _ := client.Namespaced(path)
+	showServiceMembers(app, nil, "", client.FQN(ns, row.Fields[idx]))
+}
+
+func showServiceMembers(app *App, _ ui.Tabular, _, path string) {
+	v := NewServiceMember(path)
+	if err := app.inject(v, false); err != nil {
+		app.Flash().Err(err)
+	}
+}
+
+// ServiceMember represents a cross cluster service shards, clusters and pods view.
+type ServiceMember struct {
+	ResourceViewer
+
+	service string
+}
+
+// NewServiceMember returns a new cross cluster service members view.
+func NewServiceMember(service string) ResourceViewer {
+	s := ServiceMember{
+		ResourceViewer: NewBrowser(client.NewGVR("serviceMembers")),
+		service:        service,
+	}
+	s.GetTable().SetBorderFocusColor(tcell.ColorMediumSpringGreen)
+	s.GetTable().SetSelectedStyle(tcell.StyleDefault.Foreground(tcell.ColorWhite).Background(tcell.ColorMediumSpringGreen).Attributes(tcell.AttrNone))
+	s.GetTable().SetSortCol("KIND", false)
+	s.GetTable().SetEnterFn(blankEnterFn)
+	s.SetContextFn(s.serviceContext)
+	s.AddBindKeysFn(s.bindKeys)
+
+	return &s
+}
+
+func (s *ServiceMember) serviceContext(ctx context.Context) context.Context {
+	ctx = context.WithValue(ctx, internal.KeyPath, s.service)
+
+	return context.WithValue(ctx, internal.KeyMembers, s.App().Config.K9s.FleetConfig().MemberContexts)
+}
+
+func (s *ServiceMember) bindKeys(aa ui.KeyActions) {
+	aa.Delete(ui.KeyShiftA, tcell.KeyCtrlSpace, ui.KeySpace)
+	aa.Add(ui.KeyActions{
+		ui.KeyShiftK: ui.NewKeyAction("Sort Kind", s.GetTable().SortColCmd("KIND", true), false),
+		ui.KeyShiftH: ui.NewKeyAction("Sort Shard", s.GetTable().SortColCmd("SHARD", true), false),
+		ui.KeyShiftC: ui.NewKeyAction("Sort Cluster", s.GetTable().SortColCmd("CLUSTER", true), false),
+	})
+}
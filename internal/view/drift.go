@@ -0,0 +1,216 @@
+package view
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/model"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tcell/v2"
+	"github.com/derailed/tview"
+)
+
+const (
+	driftTitle              = "Drift"
+	defaultDriftRefreshRate = 30 * time.Second
+)
+
+// Drift represents an application manifests drift view across member clusters.
+type Drift struct {
+	ResourceViewer
+
+	app string
+}
+
+// NewDrift returns a new drift view.
+func NewDrift(app string) ResourceViewer {
+	d := Drift{
+		ResourceViewer: NewBrowser(client.NewGVR("drifts")),
+		app:            app,
+	}
+	d.GetTable().SetBorderFocusColor(tcell.ColorMediumSpringGreen)
+	d.GetTable().SetSelectedStyle(tcell.StyleDefault.Foreground(tcell.ColorWhite).Background(tcell.ColorMediumSpringGreen).Attributes(tcell.AttrNone))
+	d.GetTable().GetModel().SetRefreshRate(defaultDriftRefreshRate)
+	d.GetTable().SetEnterFn(d.showDiff)
+	d.SetContextFn(d.driftContext)
+	d.AddBindKeysFn(d.bindKeys)
+
+	return &d
+}
+
+// Name returns the component name.
+func (d *Drift) Name() string { return "drifts" }
+
+func (d *Drift) driftContext(ctx context.Context) context.Context {
+	ctx = context.WithValue(ctx, internal.KeyPath, d.app)
+	return context.WithValue(ctx, internal.KeyMembers, d.App().Config.K9s.FleetConfig().MemberContexts)
+}
+
+func (d *Drift) bindKeys(aa ui.KeyActions) {
+	aa.Delete(ui.KeyShiftA, tcell.KeyCtrlSpace, ui.KeySpace)
+	aa.Add(ui.KeyActions{
+		ui.KeyShiftC: ui.NewKeyAction("Sort Cluster", d.GetTable().SortColCmd("CLUSTER", true), false),
+		ui.KeyShiftK: ui.NewKeyAction("Sort Kind", d.GetTable().SortColCmd("KIND", true), false),
+		ui.KeyShiftS: ui.NewKeyAction("Sort Status", d.GetTable().SortColCmd("STATUS", true), false),
+	})
+}
+
+func (d *Drift) showDiff(app *App, _ ui.Tabular, _, path string) {
+	if cluster, _, ok := strings.Cut(path, "/"); !ok || cluster == "" {
+		app.Flash().Errf("no manifest to diff for %s", path)
+		return
+	}
+	acc, err := dao.AccessorFor(app.factory, client.NewGVR("drifts"))
+	if err != nil {
+		app.Flash().Err(err)
+		return
+	}
+	drift, ok := acc.(*dao.Drift)
+	if !ok {
+		app.Flash().Errf("expecting a drift accessor but got %T", acc)
+		return
+	}
+
+	app.Flash().Infof("Diffing %s...", path)
+	ctx := d.driftContext(context.Background())
+	go func() {
+		desired, live, err := drift.Diff(ctx, path)
+		if err != nil {
+			app.Flash().Err(err)
+			return
+		}
+		app.QueueUpdateDraw(func() {
+			if err := app.inject(NewDriftDiff(app, path, render.SideBySide(desired, live)), false); err != nil {
+				app.Flash().Err(err)
+			}
+		})
+	}()
+}
+
+// DriftDiff represents a side by side manifests viewer, by default desired vs live.
+type DriftDiff struct {
+	*tview.Flex
+
+	app         *App
+	left, right *tview.TextView
+	actions     ui.KeyActions
+	title       string
+	subject     string
+	sides       [2]string
+	lines       []render.DiffLine
+}
+
+var _ model.Component = (*DriftDiff)(nil)
+
+// NewDriftDiff returns a new drift diff viewer.
+func NewDriftDiff(app *App, subject string, lines []render.DiffLine) *DriftDiff {
+	d := DriftDiff{
+		Flex:    tview.NewFlex(),
+		app:     app,
+		left:    tview.NewTextView(),
+		right:   tview.NewTextView(),
+		actions: make(ui.KeyActions),
+		title:   driftTitle,
+		subject: subject,
+		sides:   [2]string{"Desired", "Live"},
+		lines:   lines,
+	}
+	d.SetDirection(tview.FlexColumn)
+	d.AddItem(d.left, 0, 1, true)
+	d.AddItem(d.right, 0, 1, false)
+
+	return &d
+}
+
+// SetTitles sets the viewer title and the left and right panes titles.
+func (d *DriftDiff) SetTitles(title, left, right string) {
+	d.title, d.sides = title, [2]string{left, right}
+}
+
+// AddActions adds viewer key actions.
+func (d *DriftDiff) AddActions(aa ui.KeyActions) {
+	d.actions.Add(aa)
+}
+
+// Init initializes the viewer.
+func (d *DriftDiff) Init(_ context.Context) error {
+	d.SetBorder(true)
+	d.SetBorderPadding(0, 0, 1, 1)
+	d.SetTitle(ui.SkinTitle(fmt.Sprintf(detailsTitleFmt, d.title, d.subject), d.app.Styles.Frame()))
+	d.SetBackgroundColor(d.app.Styles.BgColor())
+	d.SetBorderFocusColor(d.app.Styles.Frame().Border.FocusColor.Color())
+	for i, t := range []*tview.TextView{d.left, d.right} {
+		t.SetDynamicColors(true).SetScrollable(true).SetWrap(false)
+		t.SetBackgroundColor(d.app.Styles.BgColor())
+		t.SetTextColor(d.app.Styles.FgColor())
+		t.SetBorder(true)
+		t.SetTitle(" " + d.sides[i] + " ")
+	}
+	d.left.SetText(diffText(d.lines, true))
+	d.right.SetText(diffText(d.lines, false))
+
+	d.actions.Add(ui.KeyActions{
+		tcell.KeyEscape: ui.NewKeyAction("Back", d.app.PrevCmd, false),
+		ui.KeyQ:         ui.NewKeyAction("Back", d.app.PrevCmd, false),
+	})
+	d.SetInputCapture(d.keyboard)
+
+	return nil
+}
+
+// keyboard scrolls both sides in lock step.
+func (d *DriftDiff) keyboard(evt *tcell.EventKey) *tcell.EventKey {
+	if a, ok := d.actions[ui.AsKey(evt)]; ok {
+		return a.Action(evt)
+	}
+	noFocus := func(tview.Primitive) {}
+	d.left.InputHandler()(evt, noFocus)
+	d.right.InputHandler()(evt, noFocus)
+
+	return nil
+}
+
+func diffText(lines []render.DiffLine, left bool) string {
+	ss := make([]string, 0, len(lines))
+	for _, l := range lines {
+		s, color := l.Right, "green"
+		if left {
+			s, color = l.Left, "red"
+		}
+		s = tview.Escape(s)
+		if l.Changed {
+			s = "[" + color + "::b]" + s + "[-::-]"
+		}
+		ss = append(ss, s)
+	}
+
+	return strings.Join(ss, "\n")
+}
+
+// InCmdMode checks if prompt is active.
+func (*DriftDiff) InCmdMode() bool { return false }
+
+// Name returns the component name.
+func (*DriftDiff) Name() string { return "drift" }
+
+// Start starts the view updater.
+func (*DriftDiff) Start() {}
+
+// Stop terminates the updater.
+func (*DriftDiff) Stop() {}
+
+// Hints returns menu hints.
+func (d *DriftDiff) Hints() model.MenuHints {
+	return d.actions.Hints()
+}
+
+// ExtraHints returns additional hints.
+func (*DriftDiff) ExtraHints() map[string]string {
+	return nil
+}
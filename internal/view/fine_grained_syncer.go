@@ -0,0 +1,70 @@
+package view
+
+import (
+	"context"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tcell/v2"
+)
+
+// FineGrainedSyncer represents a fleet fine grained syncer view.
+type FineGrainedSyncer struct {
+	ResourceViewer
+}
+
+// NewFineGrainedSyncer returns a new fine grained syncer view.
+func NewFineGrainedSyncer(gvr client.GVR) ResourceViewer {
+	f := FineGrainedSyncer{
+		ResourceViewer: NewBrowser(gvr),
+	}
+	f.GetTable().SetBorderFocusColor(tcell.ColorMediumSpringGreen)
+	f.GetTable().SetSelectedStyle(tcell.StyleDefault.Foreground(tcell.ColorWhite).Background(tcell.ColorMediumSpringGreen).Attributes(tcell.AttrNone))
+	f.GetTable().SetEnterFn(showSyncerMembers)
+
+	return &f
+}
+
+func showSyncerMembers(app *App, _ ui.Tabular, _, path string) {
+	v := NewSyncerMember(path)
+	if err := app.inject(v, false); err != nil {
+		app.Flash().Err(err)
+	}
+}
+
+// SyncerMember represents a fine grained syncer selected applications and followed resources view.
+type SyncerMember struct {
+	ResourceViewer
+
+	syncer string
+}
+
+// NewSyncerMember returns a new fine grained syncer members view.
+func NewSyncerMember(syncer string) ResourceViewer {
+	s := SyncerMember{
+		ResourceViewer: NewBrowser(client.NewGVR("syncerMembers")),
+		syncer:         syncer,
+	}
+	s.GetTable().SetBorderFocusColor(tcell.ColorMediumSpringGreen)
+	s.GetTable().SetSelectedStyle(tcell.StyleDefault.Foreground(tcell.ColorWhite).Background(tcell.ColorMediumSpringGreen).Attributes(tcell.AttrNone))
+	s.GetTable().SetSortCol("KIND", true)
+	s.GetTable().SetEnterFn(blankEnterFn)
+	s.SetContextFn(s.syncerContext)
+	s.AddBindKeysFn(s.bindKeys)
+
+	return &s
+}
+
+func (s *SyncerMember) syncerContext(ctx context.Context) context.Context {
+	return context.WithValue(ctx, internal.KeyPath, s.syncer)
+}
+
+func (s *SyncerMember) bindKeys(aa ui.KeyActions) {
+	aa.Delete(ui.KeyShiftA, tcell.KeyCtrlSpace, ui.KeySpace)
+	aa.Add(ui.KeyActions{
+		ui.KeyShiftK: ui.NewKeyAction("Sort Kind", s.GetTable().SortColCmd("KIND", true), false),
+		ui.KeyShiftF: ui.NewKeyAction("Sort Follow", s.GetTable().SortColCmd("FOLLOW", true), false),
+		ui.KeyShiftS: ui.NewKeyAction("Sort State", s.GetTable().SortColCmd("STATE", true), false),
+	})
+}
@@ -2,9 +2,15 @@ package view
 
 import (
 	"context"
+	"fmt"
+	"strings"
 
 	"github.com/derailed/k9s/internal"
 	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/model"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/k9s/internal/ui/dialog"
 	"github.com/derailed/tcell/v2"
 )
 
@@ -21,10 +27,125 @@ func NewFleetCluster(gvr client.GVR) ResourceViewer {
 	c.GetTable().SetBorderFocusColor(tcell.ColorMediumSpringGreen)
 	c.GetTable().SetSelectedStyle(tcell.StyleDefault.Foreground(tcell.ColorWhite).Background(tcell.ColorMediumSpringGreen).Attributes(tcell.AttrNone))
 	c.SetContextFn(c.clustersContext)
+	c.GetTable().SetEnterFn(c.showDetail)
+	c.AddBindKeysFn(c.bindKeys)
 
 	return &c
 }
 
+func (c *FleetClusters) bindDangerousKeys(aa ui.KeyActions) {
+	aa.Add(ui.KeyActions{
+		ui.KeyR: ui.NewKeyAction("Drain", c.toggleDrainCmd(true), true),
+		ui.KeyA: ui.NewKeyAction("Activate", c.toggleDrainCmd(false), true),
+	})
+}
+
+func (c *FleetClusters) bindKeys(aa ui.KeyActions) {
+	if !c.App().Config.K9s.IsReadOnly() {
+		c.bindDangerousKeys(aa)
+	}
+
+	aa.Add(ui.KeyActions{
+		ui.KeyP: ui.NewKeyAction("Provisioning Steps", c.showProvisioningCmd, true),
+		ui.KeyU: ui.NewKeyAction("Use Member", c.useMemberCmd, true),
+		ui.KeyX: ui.NewKeyAction("Snapshot", fleetSnapshotCmd(c.App()), true),
+	})
+}
+
+func (c *FleetClusters) useMemberCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := c.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+
+	mc, err := dao.ResolveMemberContext(c.App().factory, c.App().Config.K9s.FleetConfig().MemberContexts, path)
+	if err != nil {
+		c.App().Flash().Err(err)
+		return nil
+	}
+	if err := c.App().useMemberContext(mc); err != nil {
+		c.App().Flash().Err(err)
+		return nil
+	}
+	c.App().Flash().Infof("Switched to member cluster %s. Use :hub to return", mc.Cluster)
+
+	return nil
+}
+
+func (c *FleetClusters) showProvisioningCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := c.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+
+	if err := c.App().inject(NewProvisioningStep(path), false); err != nil {
+		c.App().Flash().Err(err)
+	}
+
+	return nil
+}
+
+func (c *FleetClusters) toggleDrainCmd(drain bool) func(evt *tcell.EventKey) *tcell.EventKey {
+	return func(evt *tcell.EventKey) *tcell.EventKey {
+		path := c.GetTable().GetSelectedItem()
+		if path == "" {
+			return evt
+		}
+		m, err := c.maintainer()
+		if err != nil {
+			c.App().Flash().Err(err)
+			return nil
+		}
+
+		_, n := client.Namespaced(path)
+		title, msg := "Confirm ", ""
+		if drain {
+			title, msg = title+"Drain", "Drain "+n+"?"
+			aa, err := m.ScheduledApplications(path)
+			if err != nil {
+				c.App().Flash().Err(err)
+				return nil
+			}
+			if len(aa) == 0 {
+				msg += "\nNo applications to migrate."
+			} else {
+				msg += fmt.Sprintf("\nApplications to migrate (%d): %s", len(aa), strings.Join(aa, ", "))
+			}
+		} else {
+			title, msg = title+"Activate", "Activate "+n+"?"
+		}
+		dialog.ShowConfirm(c.App().Styles.Dialog(), c.App().Content.Pages, title, msg, func() {
+			if err := m.ToggleDrain(path, drain); err != nil {
+				c.App().Flash().Err(err)
+				return
+			}
+			c.Refresh()
+		}, func() {})
+
+		return nil
+	}
+}
+
+func (c *FleetClusters) maintainer() (dao.FleetClusterMaintainer, error) {
+	res, err := dao.AccessorFor(c.App().factory, c.GVR())
+	if err != nil {
+		return nil, err
+	}
+	m, ok := res.(dao.FleetClusterMaintainer)
+	if !ok {
+		return nil, fmt.Errorf("expecting a maintainer for %q", c.GVR())
+	}
+
+	return m, nil
+}
+
+func (c *FleetClusters) showDetail(app *App, _ ui.Tabular, gvr, path string) {
+	v := NewLiveView(app, "Detail", model.NewDetail(client.NewGVR(gvr), path))
+	if err := app.inject(v, false); err != nil {
+		app.Flash().Err(err)
+	}
+}
+
 func (c *FleetClusters) clustersContext(ctx context.Context) context.Context {
 	key := c.GetTable().GetSelectedItem()
 	return context.WithValue(ctx, internal.KeyPath, key)
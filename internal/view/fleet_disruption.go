@@ -0,0 +1,31 @@
+package view
+
+import (
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tcell/v2"
+)
+
+// FleetDisruption represents a fleet disruption budget view.
+type FleetDisruption struct {
+	ResourceViewer
+}
+
+// NewFleetDisruption returns a new fleet disruption budget view.
+func NewFleetDisruption(gvr client.GVR) ResourceViewer {
+	f := FleetDisruption{
+		ResourceViewer: NewBrowser(gvr),
+	}
+	f.GetTable().SetBorderFocusColor(tcell.ColorMediumSpringGreen)
+	f.GetTable().SetSelectedStyle(tcell.StyleDefault.Foreground(tcell.ColorWhite).Background(tcell.ColorMediumSpringGreen).Attributes(tcell.AttrNone))
+	f.AddBindKeysFn(f.bindKeys)
+
+	return &f
+}
+
+func (f *FleetDisruption) bindKeys(aa ui.KeyActions) {
+	aa.Add(ui.KeyActions{
+		ui.KeyShiftU: ui.NewKeyAction("Sort Unavailable", f.GetTable().SortColCmd("UNAVAILABLE", false), false),
+		ui.KeyShiftS: ui.NewKeyAction("Sort Status", f.GetTable().SortColCmd(statusCol, true), false),
+	})
+}
@@ -0,0 +1,72 @@
+package view
+
+import (
+	"errors"
+
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/rs/zerolog/log"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+)
+
+// fleetHub tracks the fleet hub context while browsing a member cluster.
+type fleetHub struct {
+	context string
+	flags   *genericclioptions.ConfigFlags
+}
+
+// useMemberContext switches the app into a fleet member cluster context.
+func (a *App) useMemberContext(mc *dao.MemberContext) error {
+	cfg := a.Conn().Config()
+	hub := a.fleetHub
+	if hub == nil {
+		ctx, err := cfg.CurrentContextName()
+		if err != nil {
+			return err
+		}
+		hub = &fleetHub{context: ctx, flags: cfg.Flags()}
+	}
+	kubeConfig := hub.flags.KubeConfig
+	if mc.KubeConfig != "" {
+		kcfg := mc.KubeConfig
+		kubeConfig = &kcfg
+	}
+	cfg.SetKubeConfig(kubeConfig)
+	a.fleetHub = hub
+	a.Config.K9s.OverrideFleetMember(true)
+	if err := activateContext(a, mc.Context); err != nil {
+		if e := a.restoreHub(hub.context); e != nil {
+			log.Error().Err(e).Msgf("Unable to restore fleet hub context %q", hub.context)
+		}
+		return err
+	}
+	a.Crumbs().SetHome(hub.context)
+
+	return nil
+}
+
+// hubCmd switches the app back to the fleet hub context.
+func (a *App) hubCmd() error {
+	if a.fleetHub == nil {
+		return errors.New("not browsing a fleet member cluster")
+	}
+
+	return a.restoreHub(a.fleetHub.context)
+}
+
+// restoreHub leaves the member cluster by reinstating the fleet hub configuration
+// and switching to the given hub kubeconfig context.
+func (a *App) restoreHub(name string) error {
+	if a.Content.Top() != nil {
+		a.Content.Top().Stop()
+	}
+	if err := a.Conn().RestoreContext(a.fleetHub.flags, name); err != nil {
+		log.Error().Err(err).Msgf("Context restore failed")
+		return err
+	}
+	a.fleetHub = nil
+	a.Config.K9s.OverrideFleetMember(false)
+	a.Crumbs().SetHome("")
+	dao.ClearMemberKubeConfigs()
+
+	return a.switchContext(name)
+}
@@ -0,0 +1,92 @@
+package view
+
+import (
+	"fmt"
+	"image"
+	"sort"
+	"strings"
+
+	"github.com/derailed/k9s/internal/model"
+	"github.com/derailed/k9s/internal/ui"
+)
+
+const fleetPulseTitle = "Fleet Pulses"
+
+// fleetChart describes a fleet pulse chart and where it leads to.
+type fleetChart struct {
+	// title tracks the chart title.
+	title string
+
+	// cmd tracks the resource list the chart drills into.
+	cmd string
+
+	// col tracks the list column holding the status the chart tallies.
+	col string
+}
+
+var fleetCharts = map[string]fleetChart{
+	model.FleetClusterHealthCheck: {
+		title: "Cluster Health",
+		cmd:   "clusters",
+		col:   "STATUS",
+	},
+	// The clusters HEALTH column shows the cluster runtime state.
+	model.FleetClusterStateCheck: {
+		title: "Cluster State",
+		cmd:   "clusters",
+		col:   "HEALTH",
+	},
+	model.FleetApplicationStateCheck: {
+		title: "Applications",
+		cmd:   "applications",
+		col:   "STATE",
+	},
+	model.FleetRolloutStatusCheck: {
+		title: "Rollouts",
+		cmd:   "applications",
+		col:   "ROLLOUT",
+	},
+	model.FleetManifestWorkStateCheck: {
+		title: "ManifestWorks",
+		cmd:   "manifestworks",
+		col:   "STATE",
+	},
+}
+
+func (p *Pulse) makeFleetCharts() []Graphable {
+	return []Graphable{
+		p.makeGA(image.Point{X: 0, Y: 0}, image.Point{X: 2, Y: 3}, model.FleetClusterHealthCheck),
+		p.makeGA(image.Point{X: 0, Y: 3}, image.Point{X: 2, Y: 3}, model.FleetClusterStateCheck),
+		p.makeSP(image.Point{X: 2, Y: 0}, image.Point{X: 3, Y: 2}, model.FleetApplicationStateCheck),
+		p.makeSP(image.Point{X: 2, Y: 2}, image.Point{X: 3, Y: 2}, model.FleetRolloutStatusCheck),
+		p.makeSP(image.Point{X: 2, Y: 4}, image.Point{X: 3, Y: 2}, model.FleetManifestWorkStateCheck),
+	}
+}
+
+// gotoFiltered shows a resource list across all namespaces filtered down to the items a check deems unhealthy.
+func (p *Pulse) gotoFiltered(check string, fc fleetChart) {
+	p.App().gotoResource(fc.cmd+" all", "", false)
+	v, ok := p.App().Content.Top().(ResourceViewer)
+	if !ok || v.GetTable() == nil {
+		return
+	}
+	v.GetTable().SetColumnFilter(&ui.ColumnFilter{Column: fc.col, Excluded: model.FleetHealthyStatuses[check]})
+}
+
+func breakdown(m map[string]int64) string {
+	if len(m) == 0 {
+		return ""
+	}
+	kk := make([]string, 0, len(m))
+	for k := range m {
+		kk = append(kk, k)
+	}
+	sort.Strings(kk)
+
+	ss := make([]string, 0, len(kk))
+	for _, k := range kk {
+		ss = append(ss, fmt.Sprintf("%s:%d", k, m[k]))
+	}
+
+	return " [gray::]" + strings.Join(ss, " ") + "[-::]"
+}
@@ -0,0 +1,32 @@
+package view
+
+import (
+	"context"
+	"path/filepath"
+
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/tcell/v2"
+)
+
+// fleetSnapshotCmd exports the fleet state to a tarball in the screen dump directory.
+func fleetSnapshotCmd(app *App) func(evt *tcell.EventKey) *tcell.EventKey {
+	return func(evt *tcell.EventKey) *tcell.EventKey {
+		k9s := app.Config.K9s
+		dir := filepath.Join(k9s.GetScreenDumpDir(), k9s.CurrentContextDir())
+		opts := k9s.FleetConfig().SnapshotConfig()
+
+		app.Flash().Info("Exporting fleet snapshot...")
+		go func() {
+			ctx, cancel := context.WithTimeout(context.Background(), dao.SnapshotTimeout)
+			defer cancel()
+			path, err := dao.ExportFleetSnapshot(ctx, app.Conn(), dir, opts)
+			if err != nil {
+				app.Flash().Err(err)
+				return
+			}
+			app.Flash().Infof("Fleet snapshot saved %s", path)
+		}()
+
+		return nil
+	}
+}
@@ -10,12 +10,14 @@ import (
 	"sync"
 	"time"
 
+	"github.com/derailed/k9s/internal"
 	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/color"
 	"github.com/derailed/k9s/internal/config"
 	"github.com/derailed/k9s/internal/dao"
 	"github.com/derailed/k9s/internal/model"
 	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/k9s/internal/ui/dialog"
 	"github.com/derailed/tcell/v2"
 	"github.com/derailed/tview"
 	"github.com/rs/zerolog/log"
@@ -42,6 +44,8 @@ type Log struct {
 	cancelUpdates bool
 	mx            sync.Mutex
 	follow        bool
+	contextFn     ContextFunc
+	members       bool
 }
 
 var _ model.Component = (*Log)(nil)
@@ -57,6 +61,26 @@ func NewLog(gvr client.GVR, opts *dao.LogOptions) *Log {
 	return &l
 }
 
+// NewMemberLog returns a viewer merging an application manifest pods logs across member clusters.
+func NewMemberLog(app, manifest string) *Log {
+	l := NewLog(client.NewGVR("memberPods"), &dao.LogOptions{
+		Path:      manifest,
+		MultiPods: true,
+	})
+	l.members = true
+	l.contextFn = func(ctx context.Context) context.Context {
+		ctx = context.WithValue(ctx, internal.KeyPath, app)
+		return context.WithValue(ctx, internal.KeyMembers, l.app.Config.K9s.FleetConfig().MemberContexts)
+	}
+
+	return l
+}
+
+// SetContextFn sets a function to enhance the logs context.
+func (l *Log) SetContextFn(f ContextFunc) {
+	l.contextFn = f
+}
+
 // Init initializes the viewer.
 func (l *Log) Init(ctx context.Context) (err error) {
 	if l.app, err = extractApp(ctx); err != nil {
@@ -205,6 +229,9 @@ func (l *Log) cancel() {
 func (l *Log) getContext() context.Context {
 	l.cancel()
 	ctx := context.Background()
+	if l.contextFn != nil {
+		ctx = l.contextFn(ctx)
+	}
 	ctx, l.cancelFn = context.WithCancel(ctx)
 
 	return ctx
@@ -258,6 +285,11 @@ func (l *Log) bindKeys() {
 			ui.KeyA: ui.NewKeyAction("Toggle AllContainers", l.toggleAllContainers, true),
 		})
 	}
+	if l.members {
+		l.logs.Actions().Set(ui.KeyActions{
+			ui.KeyShiftM: ui.NewKeyAction("Mute Cluster", l.muteClusterCmd, true),
+		})
+	}
 }
 
 func (l *Log) resetCmd(evt *tcell.EventKey) *tcell.EventKey {
@@ -437,6 +469,33 @@ func saveData(screenDumpDir, context, fqn, data string) (string, error) {
 	return path, nil
 }
 
+func (l *Log) muteClusterCmd(evt *tcell.EventKey) *tcell.EventKey {
+	if l.app.InCmdMode() {
+		return evt
+	}
+	cc := l.model.Clusters()
+	if len(cc) == 0 {
+		l.app.Flash().Warn("No cluster logs yet")
+		return nil
+	}
+	options := make([]string, 0, len(cc))
+	for _, c := range cc {
+		if l.model.IsMuted(c) {
+			c += " (muted)"
+		}
+		options = append(options, c)
+	}
+	dialog.ShowSelection(l.app.Styles.Dialog(), l.app.Content.Pages, "Mute Cluster", "Toggle cluster logs", options, func(i int) {
+		if l.model.ToggleMute(cc[i]) {
+			l.app.Flash().Infof("Cluster %s logs muted", cc[i])
+			return
+		}
+		l.app.Flash().Infof("Cluster %s logs unmuted", cc[i])
+	}, func() {})
+
+	return nil
+}
+
 func (l *Log) clearCmd(*tcell.EventKey) *tcell.EventKey {
 	l.model.Clear()
 	return nil
@@ -0,0 +1,75 @@
+package view
+
+import (
+	"context"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tcell/v2"
+)
+
+// ManifestWork represents a fleet ManifestWork view.
+type ManifestWork struct {
+	ResourceViewer
+}
+
+// NewManifestWork returns a new ManifestWork view.
+func NewManifestWork(gvr client.GVR) ResourceViewer {
+	m := ManifestWork{
+		ResourceViewer: NewBrowser(gvr),
+	}
+	m.GetTable().SetBorderFocusColor(tcell.ColorMediumSpringGreen)
+	m.GetTable().SetSelectedStyle(tcell.StyleDefault.Foreground(tcell.ColorWhite).Background(tcell.ColorMediumSpringGreen).Attributes(tcell.AttrNone))
+	m.GetTable().SetEnterFn(showWorkManifests)
+	m.AddBindKeysFn(m.bindKeys)
+
+	return &m
+}
+
+func (m *ManifestWork) bindKeys(aa ui.KeyActions) {
+	aa.Add(ui.KeyActions{
+		ui.KeyShiftS: ui.NewKeyAction("Sort State", m.GetTable().SortColCmd("STATE", true), false),
+		ui.KeyShiftU: ui.NewKeyAction("Sort Non-Schedulable", m.GetTable().SortColCmd("NON-SCHEDULABLE", false), false),
+	})
+}
+
+func showWorkManifests(app *App, _ ui.Tabular, _, path string) {
+	if err := app.inject(NewWorkManifest(path), false); err != nil {
+		app.Flash().Err(err)
+	}
+}
+
+// WorkManifest represents a ManifestWork manifests view.
+type WorkManifest struct {
+	ResourceViewer
+
+	work string
+}
+
+// NewWorkManifest returns a new ManifestWork manifests view.
+func NewWorkManifest(work string) ResourceViewer {
+	w := WorkManifest{
+		ResourceViewer: NewBrowser(client.NewGVR("workManifests")),
+		work:           work,
+	}
+	w.GetTable().SetBorderFocusColor(tcell.ColorMediumSpringGreen)
+	w.GetTable().SetSelectedStyle(tcell.StyleDefault.Foreground(tcell.ColorWhite).Background(tcell.ColorMediumSpringGreen).Attributes(tcell.AttrNone))
+	w.GetTable().SetEnterFn(blankEnterFn)
+	w.SetContextFn(w.workContext)
+	w.AddBindKeysFn(w.bindKeys)
+
+	return &w
+}
+
+func (w *WorkManifest) workContext(ctx context.Context) context.Context {
+	return context.WithValue(ctx, internal.KeyPath, w.work)
+}
+
+func (w *WorkManifest) bindKeys(aa ui.KeyActions) {
+	aa.Delete(ui.KeyShiftA, tcell.KeyCtrlSpace, ui.KeySpace)
+	aa.Add(ui.KeyActions{
+		ui.KeyShiftK: ui.NewKeyAction("Sort Kind", w.GetTable().SortColCmd("KIND", true), false),
+		ui.KeyShiftR: ui.NewKeyAction("Sort Ready", w.GetTable().SortColCmd("READY", true), false),
+	})
+}
@@ -1,15 +1,27 @@
 package view
 
 import (
+	"bytes"
 	"context"
+	"errors"
+	"fmt"
+	"os"
 	"time"
 
+	"github.com/derailed/k9s/internal"
 	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/k9s/internal/ui/dialog"
+	"github.com/derailed/tcell/v2"
 )
 
 // Helm represents a helm chart view.
 type Manifest struct {
 	ResourceViewer
+
+	app string
 }
 
 // NewHelm returns a new alias view.
@@ -22,12 +34,159 @@ func NewManifest(gvr client.GVR) ResourceViewer {
 	//c.GetTable().SetBorderFocusColor(tcell.ColorMediumSpringGreen)
 	//c.GetTable().SetSelectedStyle(tcell.StyleDefault.Foreground(tcell.ColorWhite).Attributes(tcell.AttrNone))
 	c.SetContextFn(c.applicationContext)
+	c.AddBindKeysFn(c.bindKeys)
 
 	return &c
 }
 
+// NewApplicationManifest returns a manifests view for a given application.
+func NewApplicationManifest(app string) ResourceViewer {
+	c := NewManifest(client.NewGVR("manifests")).(*Manifest)
+	c.app = app
+
+	return c
+}
+
 func (c *Manifest) applicationContext(ctx context.Context) context.Context {
-	return ctx
+	if c.app == "" {
+		return ctx
+	}
+	return context.WithValue(ctx, internal.KeyPath, c.app)
+}
+
+func (c *Manifest) bindDangerousKeys(aa ui.KeyActions) {
+	aa.Add(ui.KeyActions{
+		ui.KeyE: ui.NewKeyAction("Edit Manifest", c.editManifestCmd, true),
+	})
+}
+
+func (c *Manifest) bindKeys(aa ui.KeyActions) {
+	if c.app == "" {
+		return
+	}
+	if !c.App().Config.K9s.IsReadOnly() {
+		c.bindDangerousKeys(aa)
+	}
+
+	aa.Add(ui.KeyActions{
+		ui.KeyP: ui.NewKeyAction("Member Pods", c.showMemberPodsCmd, true),
+	})
+}
+
+func (c *Manifest) showMemberPodsCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := c.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+
+	if err := c.App().inject(NewMemberPod(c.app, path), false); err != nil {
+		c.App().Flash().Err(err)
+	}
+
+	return nil
+}
+
+func (c *Manifest) editManifestCmd(evt *tcell.EventKey) *tcell.EventKey {
+	id := c.GetTable().GetSelectedItem()
+	if id == "" {
+		return evt
+	}
+	ns, _ := client.Namespaced(c.app)
+	if ok, err := c.App().Conn().CanI(ns, "apis.clusterfleet.io/v1alpha1/applications", []string{"patch"}); !ok || err != nil {
+		c.App().Flash().Err(fmt.Errorf("Current user can't edit application %s", c.app))
+		return nil
+	}
+
+	m, err := c.manifestAccessor()
+	if err != nil {
+		c.App().Flash().Err(err)
+		return nil
+	}
+	before, err := m.ManifestYAML(c.app, id)
+	if err != nil {
+		c.App().Flash().Err(err)
+		return nil
+	}
+	after, err := c.editYAML(before)
+	if err != nil {
+		c.App().Flash().Err(err)
+		return nil
+	}
+	if bytes.Equal(bytes.TrimSpace(after), bytes.TrimSpace([]byte(before))) {
+		c.App().Flash().Info("Edit cancelled, no changes made")
+		return nil
+	}
+
+	c.confirmEdit(m, id, before, after)
+
+	return nil
+}
+
+// editYAML round trips a manifest through the user editor.
+func (c *Manifest) editYAML(raw string) ([]byte, error) {
+	f, err := os.CreateTemp("", "f9s-manifest-*.yaml")
+	if err != nil {
+		return nil, err
+	}
+	defer os.Remove(f.Name())
+	if _, err := f.WriteString(raw); err != nil {
+		f.Close()
+		return nil, err
+	}
+	if err := f.Close(); err != nil {
+		return nil, err
+	}
+
+	c.Stop()
+	defer c.Start()
+	if !edit(c.App(), shellOpts{clear: true, args: []string{f.Name()}}) {
+		return nil, errors.New("Failed to launch editor")
+	}
+
+	return os.ReadFile(f.Name())
+}
+
+// confirmEdit shows the edited manifest diff and applies it once confirmed.
+func (c *Manifest) confirmEdit(m *dao.Manifest, id, before string, after []byte) {
+	edited, err := m.ValidateEdit(c.app, id, after)
+	if err != nil {
+		c.App().Flash().Err(err)
+		return
+	}
+
+	diff := NewDriftDiff(c.App(), id, render.SideBySide(before, edited))
+	diff.SetTitles("Edit", "Current", "Edited")
+	diff.AddActions(ui.KeyActions{
+		tcell.KeyCtrlS: ui.NewKeyAction("Apply", func(evt *tcell.EventKey) *tcell.EventKey {
+			msg := fmt.Sprintf("Apply manifest %s changes to application %s?", id, c.app)
+			dialog.ShowConfirm(c.App().Styles.Dialog(), c.App().Content.Pages, "Confirm Edit", msg, func() {
+				if err := m.UpdateManifest(c.app, id, after); err != nil {
+					c.App().Flash().Err(err)
+					return
+				}
+				c.App().Flash().Infof("Manifest %s updated", id)
+				c.App().PrevCmd(evt)
+				c.Refresh()
+			}, func() {})
+			return nil
+		}, true),
+	})
+	if err := c.App().inject(diff, false); err != nil {
+		c.App().Flash().Err(err)
+	}
+}
+
+func (c *Manifest) manifestAccessor() (*dao.Manifest, error) {
+	acc, err := dao.AccessorFor(c.App().factory, client.NewGVR("manifests"))
+	if err != nil {
+		return nil, err
+	}
+	m, ok := acc.(*dao.Manifest)
+	if !ok {
+		return nil, fmt.Errorf("expecting a manifest accessor but got %T", acc)
+	}
+
+	return m, nil
 }
 
 // Name returns the component name.
@@ -0,0 +1,62 @@
+package view
+
+import (
+	"context"
+	"time"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tcell/v2"
+)
+
+// MemberPod represents an application manifest pods view across member clusters.
+type MemberPod struct {
+	ResourceViewer
+
+	app, manifest string
+}
+
+// NewMemberPod returns a new member cluster pods view.
+func NewMemberPod(app, manifest string) ResourceViewer {
+	c := MemberPod{
+		ResourceViewer: NewBrowser(client.NewGVR("memberPods")),
+		app:            app,
+		manifest:       manifest,
+	}
+	c.GetTable().SetBorderFocusColor(tcell.ColorMediumSpringGreen)
+	c.GetTable().SetSelectedStyle(tcell.StyleDefault.Foreground(tcell.ColorWhite).Background(tcell.ColorMediumSpringGreen).Attributes(tcell.AttrNone))
+	c.GetTable().GetModel().SetRefreshRate(10 * time.Second)
+	c.GetTable().SetEnterFn(blankEnterFn)
+	c.SetContextFn(c.memberContext)
+	c.AddBindKeysFn(c.bindKeys)
+
+	return &c
+}
+
+// Name returns the component name.
+func (c *MemberPod) Name() string { return "pods@fleet" }
+
+func (c *MemberPod) memberContext(ctx context.Context) context.Context {
+	ctx = context.WithValue(ctx, internal.KeyPath, c.app)
+	ctx = context.WithValue(ctx, internal.KeyManifest, c.manifest)
+	return context.WithValue(ctx, internal.KeyMembers, c.App().Config.K9s.FleetConfig().MemberContexts)
+}
+
+func (c *MemberPod) bindKeys(aa ui.KeyActions) {
+	aa.Delete(ui.KeyShiftA, tcell.KeyCtrlSpace, ui.KeySpace)
+	aa.Add(ui.KeyActions{
+		ui.KeyShiftC: ui.NewKeyAction("Sort Cluster", c.GetTable().SortColCmd("CLUSTER", true), false),
+		ui.KeyShiftR: ui.NewKeyAction("Sort Ready", c.GetTable().SortColCmd("READY", true), false),
+		ui.KeyShiftS: ui.NewKeyAction("Sort Status", c.GetTable().SortColCmd("STATUS", true), false),
+		ui.KeyL:      ui.NewKeyAction("Logs", c.logsCmd, true),
+	})
+}
+
+func (c *MemberPod) logsCmd(evt *tcell.EventKey) *tcell.EventKey {
+	if err := c.App().inject(NewMemberLog(c.app, c.manifest), false); err != nil {
+		c.App().Flash().Err(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,51 @@
+package view
+
+import (
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/k9s/internal/ui/dialog"
+	"github.com/derailed/tcell/v2"
+)
+
+// Notification represents the fleet notifications history view.
+type Notification struct {
+	ResourceViewer
+}
+
+// NewNotification returns a new fleet notifications view.
+func NewNotification(gvr client.GVR) ResourceViewer {
+	n := Notification{
+		ResourceViewer: NewBrowser(gvr),
+	}
+	n.GetTable().SetBorderFocusColor(tcell.ColorMediumSpringGreen)
+	n.GetTable().SetSelectedStyle(tcell.StyleDefault.Foreground(tcell.ColorWhite).Background(tcell.ColorMediumSpringGreen).Attributes(tcell.AttrNone))
+	n.GetTable().SetEnterFn(blankEnterFn)
+	n.GetTable().SetSortCol("AGE", true)
+	n.AddBindKeysFn(n.bindKeys)
+
+	return &n
+}
+
+func (n *Notification) bindKeys(aa ui.KeyActions) {
+	aa.Delete(ui.KeyShiftA, tcell.KeyCtrlSpace, ui.KeySpace)
+	aa.Add(ui.KeyActions{
+		tcell.KeyCtrlD: ui.NewKeyAction("Clear", n.clearCmd, true),
+		ui.KeyShiftL:   ui.NewKeyAction("Sort Level", n.GetTable().SortColCmd("LEVEL", true), false),
+		ui.KeyShiftK:   ui.NewKeyAction("Sort Kind", n.GetTable().SortColCmd("KIND", true), false),
+		ui.KeyShiftR:   ui.NewKeyAction("Sort Rule", n.GetTable().SortColCmd("RULE", true), false),
+	})
+}
+
+func (n *Notification) clearCmd(evt *tcell.EventKey) *tcell.EventKey {
+	if n.GetTable().GetRowCount() <= 1 {
+		return nil
+	}
+	dialog.ShowConfirm(n.App().Styles.Dialog(), n.App().Content.Pages, "Confirm Clear", "Clear all fleet notifications?", func() {
+		dao.FleetNotifier.Clear()
+		n.App().Flash().Info("Fleet notifications cleared")
+		n.Refresh()
+	}, func() {})
+
+	return nil
+}
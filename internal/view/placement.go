@@ -0,0 +1,147 @@
+package view
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tcell/v2"
+	"github.com/derailed/tview"
+)
+
+const placementDialogKey = "placement"
+
+// Placement represents an application placement preview view.
+type Placement struct {
+	ResourceViewer
+
+	app  string
+	caps []string
+}
+
+// NewPlacement returns a new placement preview view.
+func NewPlacement(app string) ResourceViewer {
+	p := Placement{
+		ResourceViewer: NewBrowser(client.NewGVR("placements")),
+		app:            app,
+	}
+	p.GetTable().SetBorderFocusColor(tcell.ColorMediumSpringGreen)
+	p.GetTable().SetSelectedStyle(tcell.StyleDefault.Foreground(tcell.ColorWhite).Background(tcell.ColorMediumSpringGreen).Attributes(tcell.AttrNone))
+	p.GetTable().SetEnterFn(blankEnterFn)
+	p.SetContextFn(p.placementContext)
+	p.AddBindKeysFn(p.bindKeys)
+
+	return &p
+}
+
+// Name returns the component name.
+func (p *Placement) Name() string { return "placement" }
+
+func (p *Placement) placementContext(ctx context.Context) context.Context {
+	ctx = context.WithValue(ctx, internal.KeyPath, p.app)
+	if p.caps == nil {
+		return ctx
+	}
+
+	return context.WithValue(ctx, internal.KeyCapabilities, p.caps)
+}
+
+func (p *Placement) bindKeys(aa ui.KeyActions) {
+	aa.Delete(ui.KeyShiftA, tcell.KeyCtrlSpace, ui.KeySpace)
+	aa.Add(ui.KeyActions{
+		ui.KeyC:      ui.NewKeyAction("Capabilities", p.capabilitiesCmd, true),
+		ui.KeyR:      ui.NewKeyAction("Reset Capabilities", p.resetCmd, true),
+		ui.KeyShiftC: ui.NewKeyAction("Sort Cluster", p.GetTable().SortColCmd("CLUSTER", true), false),
+		ui.KeyShiftE: ui.NewKeyAction("Sort Eligible", p.GetTable().SortColCmd("ELIGIBLE", true), false),
+	})
+}
+
+func (p *Placement) resetCmd(evt *tcell.EventKey) *tcell.EventKey {
+	if p.caps == nil {
+		return nil
+	}
+	p.caps = nil
+	p.App().Flash().Info("Placement preview reset to application capabilities")
+	p.Refresh()
+
+	return nil
+}
+
+func (p *Placement) capabilitiesCmd(evt *tcell.EventKey) *tcell.EventKey {
+	caps := strings.Join(p.currentCapabilities(), ",")
+
+	f := tview.NewForm()
+	f.SetItemPadding(0)
+	f.SetButtonsAlign(tview.AlignCenter).
+		SetButtonBackgroundColor(tview.Styles.PrimitiveBackgroundColor).
+		SetButtonTextColor(tview.Styles.PrimaryTextColor).
+		SetLabelColor(tcell.ColorAqua).
+		SetFieldTextColor(tcell.ColorOrange)
+	f.AddInputField("Capabilities:", caps, 40, nil, func(changed string) {
+		caps = changed
+	})
+	f.AddButton("OK", func() {
+		defer p.dismissDialog()
+		cc, err := parseCapabilities(caps)
+		if err != nil {
+			p.App().Flash().Err(err)
+			return
+		}
+		p.caps = cc
+		p.App().Flash().Infof("Previewing placement requiring [%s]", strings.Join(cc, ","))
+		p.Refresh()
+	})
+	f.AddButton("Cancel", func() {
+		p.dismissDialog()
+	})
+
+	modal := tview.NewModalForm("<Placement Preview>", f)
+	modal.SetText("Required capabilities (comma separated)")
+	modal.SetDoneFunc(func(int, string) {
+		p.dismissDialog()
+	})
+	p.App().Content.AddPage(placementDialogKey, modal, false, false)
+	p.App().Content.ShowPage(placementDialogKey)
+
+	return nil
+}
+
+func (p *Placement) dismissDialog() {
+	p.App().Content.RemovePage(placementDialogKey)
+}
+
+// currentCapabilities returns the capabilities currently previewed.
+func (p *Placement) currentCapabilities() []string {
+	if p.caps != nil {
+		return p.caps
+	}
+	app, err := dao.FetchApplication(p.App().factory, p.app)
+	if err != nil {
+		return nil
+	}
+
+	return app.Spec.ClusterSelectors.Capabilities
+}
+
+// parseCapabilities parses a comma separated list of component capabilities.
+func parseCapabilities(s string) ([]string, error) {
+	cc := make([]string, 0, len(render.ComponentCapabilities))
+	for _, t := range strings.Split(s, ",") {
+		t = strings.TrimSpace(t)
+		if t == "" {
+			continue
+		}
+		c, ok := render.LookupCapability(t)
+		if !ok {
+			return nil, fmt.Errorf("unknown capability %q", t)
+		}
+		cc = append(cc, string(c))
+	}
+
+	return cc, nil
+}
@@ -0,0 +1,40 @@
+package view
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseCapabilities(t *testing.T) {
+	uu := map[string]struct {
+		s   string
+		e   []string
+		err string
+	}{
+		"empty": {
+			e: []string{},
+		},
+		"multi": {
+			s: " ingress, Logs ,,DATA",
+			e: []string{"Ingress", "Logs", "Data"},
+		},
+		"unknown": {
+			s:   "Ingress,Blee",
+			err: `unknown capability "Blee"`,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			cc, err := parseCapabilities(u.s)
+			if u.err != "" {
+				assert.EqualError(t, err, u.err)
+				return
+			}
+			assert.Nil(t, err)
+			assert.Equal(t, u.e, cc)
+		})
+	}
+}
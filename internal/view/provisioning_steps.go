@@ -0,0 +1,45 @@
+package view
+
+import (
+	"context"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tcell/v2"
+)
+
+// ProvisioningStep represents a fleet cluster provisioning steps view.
+type ProvisioningStep struct {
+	ResourceViewer
+
+	cluster string
+}
+
+// NewProvisioningStep returns a new provisioning steps view.
+func NewProvisioningStep(cluster string) ResourceViewer {
+	p := ProvisioningStep{
+		ResourceViewer: NewBrowser(client.NewGVR("provisioningSteps")),
+		cluster:        cluster,
+	}
+	p.GetTable().SetBorderFocusColor(tcell.ColorMediumSpringGreen)
+	p.GetTable().SetSelectedStyle(tcell.StyleDefault.Foreground(tcell.ColorWhite).Background(tcell.ColorMediumSpringGreen).Attributes(tcell.AttrNone))
+	p.GetTable().SetSortCol("#", true)
+	p.GetTable().SetEnterFn(blankEnterFn)
+	p.SetContextFn(p.clusterContext)
+	p.AddBindKeysFn(p.bindKeys)
+
+	return &p
+}
+
+func (p *ProvisioningStep) clusterContext(ctx context.Context) context.Context {
+	return context.WithValue(ctx, internal.KeyPath, p.cluster)
+}
+
+func (p *ProvisioningStep) bindKeys(aa ui.KeyActions) {
+	aa.Delete(ui.KeyShiftA, tcell.KeyCtrlSpace, ui.KeySpace)
+	aa.Add(ui.KeyActions{
+		ui.KeyShiftO: ui.NewKeyAction("Sort Order", p.GetTable().SortColCmd("#", true), false),
+		ui.KeyShiftS: ui.NewKeyAction("Sort Status", p.GetTable().SortColCmd(statusCol, true), false),
+	})
+}
@@ -69,6 +69,7 @@ type Pulse struct {
 func NewPulse(gvr client.GVR) ResourceViewer {
 	return &Pulse{
 		Grid:    tview.NewGrid(),
+		gvr:     gvr,
 		model:   model.NewPulse(gvr.String()),
 		actions: make(ui.KeyActions),
 	}
@@ -85,7 +86,23 @@ func (p *Pulse) Init(ctx context.Context) error {
 		return err
 	}
 
-	p.charts = []Graphable{
+	if p.gvr.String() == model.FleetPulseGVR {
+		p.SetTitle(fmt.Sprintf(" %s ", fleetPulseTitle))
+		p.charts = p.makeFleetCharts()
+	} else {
+		p.charts = p.makeCharts()
+	}
+	p.bindKeys()
+	p.model.AddListener(p)
+	p.app.SetFocus(p.charts[0])
+	p.app.Styles.AddListener(p)
+	p.StylesChanged(p.app.Styles)
+
+	return nil
+}
+
+func (p *Pulse) makeCharts() []Graphable {
+	charts := []Graphable{
 		p.makeGA(image.Point{X: 0, Y: 0}, image.Point{X: 2, Y: 2}, "apps/v1/deployments"),
 		p.makeGA(image.Point{X: 0, Y: 2}, image.Point{X: 2, Y: 2}, "apps/v1/replicasets"),
 		p.makeGA(image.Point{X: 0, Y: 4}, image.Point{X: 2, Y: 2}, "apps/v1/statefulsets"),
@@ -96,18 +113,13 @@ func (p *Pulse) Init(ctx context.Context) error {
 		p.makeSP(image.Point{X: 2, Y: 6}, image.Point{X: 3, Y: 2}, "v1/persistentvolumes"),
 	}
 	if p.app.Conn().HasMetrics() {
-		p.charts = append(p.charts,
+		charts = append(charts,
 			p.makeSP(image.Point{X: 5, Y: 0}, image.Point{X: 2, Y: 4}, "cpu"),
 			p.makeSP(image.Point{X: 5, Y: 4}, image.Point{X: 2, Y: 4}, "mem"),
 		)
 	}
-	p.bindKeys()
-	p.model.AddListener(p)
-	p.app.SetFocus(p.charts[0])
-	p.app.Styles.AddListener(p)
-	p.StylesChanged(p.app.Styles)
 
-	return nil
+	return charts
 }
 
 // InCmdMode checks if prompt is active.
@@ -185,12 +197,12 @@ func (p *Pulse) PulseChanged(c *health.Check) {
 		))
 	default:
 		v.SetLegend(fmt.Sprintf(genFmat,
-			cases.Title(language.Und, cases.NoLower).String(gvr.R()),
+			chartTitle(c.GVR),
 			nn[0],
 			c.Tally(health.S1),
 			nn[1],
 			c.Tally(health.S2),
-		))
+		) + breakdown(c.Breakdown))
 	}
 	v.Add(tchart.Metric{S1: c.Tally(health.S1), S2: c.Tally(health.S2)})
 }
@@ -208,8 +220,7 @@ func (p *Pulse) bindKeys() {
 	})
 
 	for i, v := range p.charts {
-		t := cases.Title(language.Und, cases.NoLower).String(client.NewGVR(v.ID()).R())
-		p.actions[ui.NumKeys[i]] = ui.NewKeyAction(t, p.sparkFocusCmd(i), true)
+		p.actions[ui.NumKeys[i]] = ui.NewKeyAction(chartTitle(v.ID()), p.sparkFocusCmd(i), true)
 	}
 }
 
@@ -310,6 +321,10 @@ func (p *Pulse) enterCmd(evt *tcell.EventKey) *tcell.EventKey {
 	if !ok {
 		return nil
 	}
+	if fc, ok := fleetCharts[s.ID()]; ok {
+		p.gotoFiltered(s.ID(), fc)
+		return nil
+	}
 	res := client.NewGVR(s.ID()).R()
 	if res == "cpu" || res == "mem" {
 		res = "pod"
@@ -342,7 +357,7 @@ func (p *Pulse) makeSP(loc image.Point, span image.Point, gvr string) *tchart.Sp
 	} else {
 		s.SetSeriesColors(p.app.Styles.Charts().DefaultChartColors.Colors()...)
 	}
-	s.SetLegend(fmt.Sprintf(" %s ", cases.Title(language.Und, cases.NoLower).String(client.NewGVR(gvr).R())))
+	s.SetLegend(fmt.Sprintf(" %s ", chartTitle(gvr)))
 	s.SetInputCapture(p.keyboard)
 	s.SetMultiSeries(true)
 	p.AddItem(s, loc.X, loc.Y, span.X, span.Y, 0, 0, true)
@@ -360,7 +375,7 @@ func (p *Pulse) makeGA(loc image.Point, span image.Point, gvr string) *tchart.Ga
 	} else {
 		g.SetSeriesColors(p.app.Styles.Charts().DefaultDialColors.Colors()...)
 	}
-	g.SetLegend(fmt.Sprintf(" %s ", cases.Title(language.Und, cases.NoLower).String(client.NewGVR(gvr).R())))
+	g.SetLegend(fmt.Sprintf(" %s ", chartTitle(gvr)))
 	g.SetInputCapture(p.keyboard)
 	p.AddItem(g, loc.X, loc.Y, span.X, span.Y, 0, 0, true)
 
@@ -370,6 +385,14 @@ func (p *Pulse) makeGA(loc image.Point, span image.Point, gvr string) *tchart.Ga
 // ----------------------------------------------------------------------------
 // Helpers
 
+func chartTitle(id string) string {
+	if fc, ok := fleetCharts[id]; ok {
+		return fc.title
+	}
+
+	return cases.Title(language.Und, cases.NoLower).String(client.NewGVR(id).R())
+}
+
 func nextFocus(pp []Graphable, index int) (int, tview.Primitive) {
 	if index >= len(pp) {
 		return 0, pp[0]
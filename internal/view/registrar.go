@@ -32,10 +32,40 @@ func falconFleetViewers(vv MetaViewers) {
 	vv[client.NewGVR("manifests")] = MetaViewer{
 		viewerFn: NewManifest,
 	}
+	vv[client.NewGVR("notifications")] = MetaViewer{
+		viewerFn: NewNotification,
+	}
 
 	vv[client.NewGVR("apis.clusterfleet.io/v1alpha1/clusters")] = MetaViewer{
 		viewerFn: NewFleetCluster,
 	}
+	vv[client.NewGVR("apis.clusterfleet.io/v1alpha1/clusterhealthpolicies")] = MetaViewer{
+		viewerFn: NewClusterHealth,
+	}
+	vv[client.NewGVR("apis.clusterfleet.io/v1alpha1/clusterhealthreports")] = MetaViewer{
+		viewerFn: NewClusterHealth,
+	}
+	vv[client.NewGVR("apis.clusterfleet.io/v1alpha1/fleetdisruptions")] = MetaViewer{
+		viewerFn: NewFleetDisruption,
+	}
+	vv[client.NewGVR("apis.clusterfleet.io/v1alpha1/manifestworks")] = MetaViewer{
+		viewerFn: NewManifestWork,
+	}
+	vv[client.NewGVR("apis.clusterfleet.io/v1alpha1/clusterdefinitions")] = MetaViewer{
+		viewerFn: NewClusterDefinition,
+	}
+	vv[client.NewGVR("apis.clusterfleet.io/v1alpha1/clusterprovisioners")] = MetaViewer{
+		viewerFn: NewClusterProvisioner,
+	}
+	vv[client.NewGVR("apis.clusterfleet.io/v1alpha1/crossclusterservices")] = MetaViewer{
+		viewerFn: NewCrossClusterService,
+	}
+	vv[client.NewGVR("apis.clusterfleet.io/v1alpha1/crossclustershards")] = MetaViewer{
+		viewerFn: NewCrossClusterShard,
+	}
+	vv[client.NewGVR("apis.clusterfleet.io/v1alpha1/finegrainedsyncers")] = MetaViewer{
+		viewerFn: NewFineGrainedSyncer,
+	}
 }
 
 func coreViewers(vv MetaViewers) {
@@ -100,6 +130,9 @@ func miscViewers(vv MetaViewers) {
 	vv[client.NewGVR("pulses")] = MetaViewer{
 		viewerFn: NewPulse,
 	}
+	vv[client.NewGVR("fleetpulses")] = MetaViewer{
+		viewerFn: NewPulse,
+	}
 	vv[client.NewGVR("popeye")] = MetaViewer{
 		viewerFn: NewPopeye,
 	}
@@ -0,0 +1,179 @@
+package view
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/tchart"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tcell/v2"
+	"github.com/derailed/tview"
+)
+
+const (
+	rolloutGaugeHeight = 7
+	rolloutFmat        = " %s [::b]%s[::-] v%s ([%s::]%d[white::] converged:[%s::]%d[-::] pending) min-available %s max-unavailable %s "
+)
+
+// Rollout represents a fleet application rollout progress view.
+type Rollout struct {
+	ResourceViewer
+
+	flex  *tview.Flex
+	gauge *tchart.Gauge
+	path  string
+}
+
+// NewRollout returns a new rollout progress view.
+func NewRollout(path string) ResourceViewer {
+	r := Rollout{
+		ResourceViewer: NewBrowser(client.NewGVR("rollouts")),
+		flex:           tview.NewFlex().SetDirection(tview.FlexRow),
+		gauge:          tchart.NewGauge("rollouts"),
+		path:           path,
+	}
+	r.GetTable().SetBorderFocusColor(tcell.ColorMediumSpringGreen)
+	r.GetTable().SetSelectedStyle(tcell.StyleDefault.Foreground(tcell.ColorWhite).Background(tcell.ColorMediumSpringGreen).Attributes(tcell.AttrNone))
+	r.GetTable().SetEnterFn(blankEnterFn)
+	r.SetContextFn(r.rolloutContext)
+	r.AddBindKeysFn(r.bindKeys)
+
+	return &r
+}
+
+// Init initializes the view.
+func (r *Rollout) Init(ctx context.Context) error {
+	if err := r.ResourceViewer.Init(ctx); err != nil {
+		return err
+	}
+	r.gauge.SetLegend(" Rollout ")
+	r.flex.AddItem(r.gauge, rolloutGaugeHeight, 0, false)
+	r.flex.AddItem(r.GetTable(), 0, 1, true)
+	r.App().Styles.AddListener(r)
+	r.StylesChanged(r.App().Styles)
+
+	return nil
+}
+
+// Name returns the component name.
+func (r *Rollout) Name() string {
+	return "rollout"
+}
+
+// Start starts the view updates.
+func (r *Rollout) Start() {
+	r.ResourceViewer.Start()
+	r.GetTable().GetModel().AddListener(r)
+}
+
+// Stop terminates the view updates.
+func (r *Rollout) Stop() {
+	r.GetTable().GetModel().RemoveListener(r)
+	r.App().Styles.RemoveListener(r)
+	r.ResourceViewer.Stop()
+}
+
+// StylesChanged notifies the skin changed.
+func (r *Rollout) StylesChanged(s *config.Styles) {
+	r.flex.SetBackgroundColor(s.BgColor())
+	r.gauge.SetFocusColorNames(s.Table().BgColor.String(), s.Table().CursorBgColor.String())
+	r.gauge.SetBackgroundColor(s.Charts().DialBgColor.Color())
+	r.gauge.SetSeriesColors(s.Charts().DefaultDialColors.Colors()...)
+	if cc, ok := s.Charts().ResourceColors[r.gauge.ID()]; ok {
+		r.gauge.SetSeriesColors(cc.Colors()...)
+	}
+}
+
+// TableDataChanged notifies the rollout progress changed.
+func (r *Rollout) TableDataChanged(data *render.TableData) {
+	app, err := dao.FetchApplication(r.App().factory, r.path)
+	if err != nil {
+		r.TableLoadFailed(err)
+		return
+	}
+	converged, total := render.RolloutProgress(app)
+	maxUnavailable := rolloutBudget(data)
+	nn := r.gauge.GetSeriesColorNames()
+	r.App().QueueUpdateDraw(func() {
+		r.gauge.SetLegend(fmt.Sprintf(rolloutFmat,
+			app.Name,
+			render.NA(string(app.Status.RolloutStatus)),
+			render.NA(app.Spec.Version),
+			nn[0],
+			converged,
+			nn[1],
+			total-converged,
+			render.NA(app.Spec.RolloutStrategy.MinAvailableReplicas),
+			maxUnavailable,
+		))
+		r.gauge.Add(tchart.Metric{S1: int64(converged), S2: int64(total - converged)})
+	})
+}
+
+// rolloutBudget returns the rollout disruption budget carried by the table rows.
+func rolloutBudget(data *render.TableData) string {
+	col := data.Header.IndexOf("MAX-UNAVAILABLE", true)
+	if col == -1 || data.Empty() {
+		return render.NAValue
+	}
+
+	return data.RowEvents[0].Row.Fields[col]
+}
+
+// TableLoadFailed notifies the load failed.
+func (r *Rollout) TableLoadFailed(err error) {
+	r.App().QueueUpdateDraw(func() {
+		r.App().Flash().Err(err)
+	})
+}
+
+func (r *Rollout) rolloutContext(ctx context.Context) context.Context {
+	return context.WithValue(ctx, internal.KeyPath, r.path)
+}
+
+func (r *Rollout) bindKeys(aa ui.KeyActions) {
+	aa.Delete(ui.KeyShiftA, tcell.KeyCtrlSpace, ui.KeySpace)
+	aa.Add(ui.KeyActions{
+		ui.KeyShiftC: ui.NewKeyAction("Sort Cluster", r.GetTable().SortColCmd("CLUSTER", true), false),
+		ui.KeyShiftS: ui.NewKeyAction("Sort State", r.GetTable().SortColCmd("STATE", true), false),
+	})
+}
+
+// ----------------------------------------------------------------------------
+// Primitive protocol...
+
+// Draw draws the view.
+func (r *Rollout) Draw(sc tcell.Screen) { r.flex.Draw(sc) }
+
+// GetRect returns the view position.
+func (r *Rollout) GetRect() (int, int, int, int) { return r.flex.GetRect() }
+
+// SetRect sets the view position.
+func (r *Rollout) SetRect(x, y, width, height int) { r.flex.SetRect(x, y, width, height) }
+
+// InputHandler returns the view input handler.
+func (r *Rollout) InputHandler() func(*tcell.EventKey, func(tview.Primitive)) {
+	return r.flex.InputHandler()
+}
+
+// MouseHandler returns the view mouse handler.
+func (r *Rollout) MouseHandler() func(tview.MouseAction, *tcell.EventMouse, func(tview.Primitive)) (bool, tview.Primitive) {
+	return r.flex.MouseHandler()
+}
+
+// Focus delegates focus to the rollout table.
+func (r *Rollout) Focus(delegate func(tview.Primitive)) { r.flex.Focus(delegate) }
+
+// HasFocus checks if the view has focus.
+func (r *Rollout) HasFocus() bool { return r.flex.HasFocus() }
+
+// Blur removes the view focus.
+func (r *Rollout) Blur() { r.flex.Blur() }
+
+// GetFocusable returns the view focusable.
+func (r *Rollout) GetFocusable() tview.Focusable { return r.flex.GetFocusable() }
@@ -33,12 +33,13 @@ var _ ResourceViewer = (*Xray)(nil)
 type Xray struct {
 	*ui.Tree
 
-	app      *App
-	gvr      client.GVR
-	meta     metav1.APIResource
-	model    *model.Tree
-	cancelFn context.CancelFunc
-	envFn    EnvFunc
+	app       *App
+	gvr       client.GVR
+	meta      metav1.APIResource
+	model     *model.Tree
+	cancelFn  context.CancelFunc
+	envFn     EnvFunc
+	contextFn ContextFunc
 }
 
 // NewXray returns a new view.
@@ -163,7 +164,7 @@ func (x *Xray) refreshActions() {
 	}
 
 	switch gvr {
-	case "v1/namespaces":
+	case "v1/namespaces", "manifests", "liveObjects":
 		x.Actions().Delete(tcell.KeyEnter)
 	case "containers":
 		x.Actions().Delete(tcell.KeyEnter)
@@ -575,6 +576,7 @@ func (x *Xray) BufferActive(state bool, k model.BufferKind) {
 func (x *Xray) defaultContext() context.Context {
 	ctx := context.WithValue(context.Background(), internal.KeyFactory, x.app.factory)
 	ctx = context.WithValue(ctx, internal.KeyFields, "")
+	ctx = context.WithValue(ctx, internal.KeyMembers, x.app.Config.K9s.FleetConfig().MemberContexts)
 	if x.CmdBuff().Empty() {
 		ctx = context.WithValue(ctx, internal.KeyLabels, "")
 	} else {
@@ -590,6 +592,9 @@ func (x *Xray) Start() {
 	x.CmdBuff().AddListener(x)
 
 	ctx := x.defaultContext()
+	if x.contextFn != nil {
+		ctx = x.contextFn(ctx)
+	}
 	ctx, x.cancelFn = context.WithCancel(ctx)
 	x.model.Watch(ctx)
 	x.UpdateTitle()
@@ -609,7 +614,9 @@ func (x *Xray) Stop() {
 func (x *Xray) AddBindKeysFn(BindKeysFunc) {}
 
 // SetContextFn sets custom context.
-func (x *Xray) SetContextFn(ContextFunc) {}
+func (x *Xray) SetContextFn(f ContextFunc) {
+	x.contextFn = f
+}
 
 // Name returns the component name.
 func (x *Xray) Name() string { return "XRay" }
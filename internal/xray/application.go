@@ -0,0 +1,144 @@
+package xray
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/render"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+const (
+	applicationGVR  = "apis.clusterfleet.io/v1alpha1/applications"
+	fleetClusterGVR = "apis.clusterfleet.io/v1alpha1/clusters"
+	manifestGVR     = "manifests"
+	liveObjectGVR   = "liveObjects"
+)
+
+// Application represents an xray renderer.
+type Application struct{}
+
+// Render renders an xray node.
+func (a *Application) Render(ctx context.Context, ns string, o interface{}) error {
+	raw, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return fmt.Errorf("Expected Unstructured, but got %T", o)
+	}
+	app, err := render.FleetObjects.Application(raw)
+	if err != nil {
+		return err
+	}
+
+	parent, ok := ctx.Value(KeyParent).(*TreeNode)
+	if !ok {
+		return fmt.Errorf("Expecting a TreeNode but got %T", ctx.Value(KeyParent))
+	}
+	f, ok := ctx.Value(internal.KeyFactory).(dao.Factory)
+	if !ok {
+		return fmt.Errorf("Expecting a factory but got %T", ctx.Value(internal.KeyFactory))
+	}
+
+	root := NewTreeNode(applicationGVR, client.FQN(app.Namespace, app.Name))
+	desired := desiredManifests(app)
+	for _, cs := range app.Status.Clusters {
+		cn := NewTreeNode(fleetClusterGVR, client.FQN(client.ClusterScope, cs.Cluster))
+		live, fetched := liveObjects.get(ctx, f, app, cs.Cluster, desired)
+		for i := range cs.ManifestStatuses {
+			ms := cs.ManifestStatuses[i]
+			key := manifestKey(ms.Namespace, ms.Name, ms.Kind)
+			mn := manifestNode(ms, desired[key])
+			if mo, ok := desired[key]; ok {
+				mn.Add(liveNode(mo, live[key], fetched))
+			}
+			cn.Add(mn)
+		}
+		validateReady(cn, cs.Conditions)
+		root.Add(cn)
+	}
+	validateReady(root, app.Status.Conditions)
+	if app.Spec.Version != "" {
+		root.Extras[InfoKey] = app.Spec.Version
+	}
+
+	gvr, nsID := "v1/namespaces", client.FQN(client.ClusterScope, app.Namespace)
+	nsn := parent.Find(gvr, nsID)
+	if nsn == nil {
+		nsn = NewTreeNode(gvr, nsID)
+		parent.Add(nsn)
+	}
+	nsn.Add(root)
+
+	return nil
+}
+
+func manifestNode(ms render.ManifestStatus, desired *unstructured.Unstructured) *TreeNode {
+	n := NewTreeNode(manifestGVR, client.FQN(ms.Namespace, ms.Name+"_"+ms.Kind))
+	h := render.InterpretManifestStatus(ms.Kind, desired, &ms)
+	n.Extras[InfoKey] = h.Status + " " + h.Ready
+	if h.Err != nil {
+		n.Extras[StatusKey] = ToastStatus
+	}
+
+	return n
+}
+
+// liveNode describes the live object of a manifest on its member cluster.
+// Live lookup issues only flag the leaf so unreachable members do not override the Ready coloring.
+func liveNode(mo *unstructured.Unstructured, lo dao.LiveObject, fetched bool) *TreeNode {
+	n := NewTreeNode(liveObjectGVR, client.FQN(mo.GetNamespace(), mo.GetName()))
+	switch {
+	case !fetched:
+		n.Extras[InfoKey] = livePendingStatus
+	case lo.Missing:
+		n.Extras[InfoKey], n.Extras[StatusKey] = render.MissingStatus, MissingRefStatus
+	case lo.Err != nil:
+		n.Extras[InfoKey], n.Extras[StatusKey] = render.UnreachableStatus, ToastStatus
+	case len(render.ManifestDrift(mo, lo.Object)) > 0:
+		n.Extras[InfoKey], n.Extras[StatusKey] = render.DriftedStatus, ToastStatus
+	default:
+		n.Extras[InfoKey] = render.InSyncStatus
+	}
+
+	return n
+}
+
+// validateReady toasts a node whose Ready condition is not met or whose children are toasted.
+func validateReady(n *TreeNode, cc []metav1.Condition) {
+	if !apimeta.IsStatusConditionTrue(cc, "Ready") {
+		n.Extras[StatusKey] = ToastStatus
+		return
+	}
+	for _, c := range n.Children {
+		if c.Extras[StatusKey] != OkStatus {
+			n.Extras[StatusKey] = ToastStatus
+			return
+		}
+	}
+}
+
+func desiredManifests(app *render.Application) map[string]*unstructured.Unstructured {
+	mm := make(map[string]*unstructured.Unstructured, len(app.Spec.Workload))
+	for _, m := range render.FleetObjects.Manifests(app) {
+		if m.Err != nil {
+			continue
+		}
+		o := m.Object
+		key := manifestKey(
+			render.GetNamespaceFromUnstructured(o),
+			render.GetNameFromUnstructured(o),
+			render.GetKindFromUnstructured(o),
+		)
+		mm[key] = o
+	}
+
+	return mm
+}
+
+func manifestKey(ns, n, kind string) string {
+	return ns + "." + n + "." + kind
+}
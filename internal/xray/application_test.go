@@ -0,0 +1,72 @@
+package xray_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/xray"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestApplicationRender(t *testing.T) {
+	var re xray.Application
+	root := xray.NewTreeNode("applications", "applications")
+	ctx := context.WithValue(context.Background(), xray.KeyParent, root)
+	ctx = context.WithValue(ctx, internal.KeyFactory, makeFactory())
+
+	assert.Nil(t, re.Render(ctx, "", load(t, "app")))
+	assert.Equal(t, 1, root.CountChildren())
+
+	ns := root.Children[0]
+	assert.Equal(t, "-/default", ns.ID)
+	assert.Equal(t, 1, ns.CountChildren())
+
+	app := ns.Children[0]
+	assert.Equal(t, "default/web", app.ID)
+	assert.Equal(t, "v2", app.Extras[xray.InfoKey])
+	assert.Equal(t, xray.ToastStatus, app.Extras[xray.StatusKey])
+	assert.Equal(t, 2, app.CountChildren())
+
+	c1, c2 := app.Children[0], app.Children[1]
+	assert.Equal(t, "-/c1", c1.ID)
+	assert.Equal(t, xray.OkStatus, c1.Extras[xray.StatusKey])
+	assert.Equal(t, "-/c2", c2.ID)
+	assert.Equal(t, xray.ToastStatus, c2.Extras[xray.StatusKey])
+
+	assert.Equal(t, "default/web_Deployment", c1.Children[0].ID)
+	assert.Equal(t, xray.OkStatus, c1.Children[0].Extras[xray.StatusKey])
+	assert.Equal(t, "Available 2/2", c1.Children[0].Extras[xray.InfoKey])
+	assert.Equal(t, xray.ToastStatus, c2.Children[0].Extras[xray.StatusKey])
+
+	live := c1.Children[0].Children[0]
+	assert.Equal(t, "liveObjects", live.GVR)
+	assert.Equal(t, "default/web", live.ID)
+}
+
+func TestApplicationRenderLiveObjects(t *testing.T) {
+	var re xray.Application
+	liveLeaf := func() *xray.TreeNode {
+		root := xray.NewTreeNode("applications", "applications")
+		ctx := context.WithValue(context.Background(), xray.KeyParent, root)
+		ctx = context.WithValue(ctx, internal.KeyFactory, makeFactory())
+		assert.Nil(t, re.Render(ctx, "", load(t, "app")))
+
+		return root.Children[0].Children[0].Children[0].Children[0].Children[0]
+	}
+
+	assert.Eventually(t, func() bool {
+		return liveLeaf().Extras[xray.InfoKey] == render.UnreachableStatus
+	}, time.Second, 10*time.Millisecond)
+	assert.Equal(t, xray.ToastStatus, liveLeaf().Extras[xray.StatusKey])
+}
+
+func TestApplicationRenderNoFactory(t *testing.T) {
+	var re xray.Application
+	root := xray.NewTreeNode("applications", "applications")
+	ctx := context.WithValue(context.Background(), xray.KeyParent, root)
+
+	assert.NotNil(t, re.Render(ctx, "", load(t, "app")))
+}
@@ -0,0 +1,91 @@
+package xray
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/render"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const (
+	definitionPhaseGVR = "definitionphases"
+	definitionTaskGVR  = "definitiontasks"
+	taskRefGVR         = "taskrefs"
+)
+
+// ClusterDefinition represents an xray renderer.
+type ClusterDefinition struct{}
+
+// Render renders an xray node.
+func (c *ClusterDefinition) Render(ctx context.Context, ns string, o interface{}) error {
+	raw, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return fmt.Errorf("Expected Unstructured, but got %T", o)
+	}
+	var def render.ClusterDefinition
+	err := runtime.DefaultUnstructuredConverter.FromUnstructured(raw.Object, &def)
+	if err != nil {
+		return err
+	}
+
+	parent, ok := ctx.Value(KeyParent).(*TreeNode)
+	if !ok {
+		return fmt.Errorf("Expecting a TreeNode but got %T", ctx.Value(KeyParent))
+	}
+
+	root := NewTreeNode(dao.ClusterDefinitionGVR, client.FQN(client.ClusterScope, def.Name))
+	edges := render.TaskEdges(def.Spec)
+	for _, ph := range render.DefinitionPhases(def.Spec) {
+		if len(ph.Tasks) == 0 {
+			continue
+		}
+		pn := NewTreeNode(definitionPhaseGVR, ph.Name)
+		for _, t := range ph.Tasks {
+			tn := NewTreeNode(definitionTaskGVR, t.Name)
+			tn.Extras[InfoKey] = t.TaskType
+			for _, e := range edges {
+				if e.Phase != ph.Name || e.Task != t.Name {
+					continue
+				}
+				tn.Add(c.edgeNode(e))
+			}
+			pn.Add(tn)
+		}
+		root.Add(pn)
+	}
+	c.validate(root)
+	parent.Add(root)
+
+	return nil
+}
+
+func (*ClusterDefinition) edgeNode(e render.TaskEdge) *TreeNode {
+	n := NewTreeNode(taskRefGVR, e.String())
+	switch {
+	case e.Dangling:
+		n.Extras[StatusKey], n.Extras[InfoKey] = MissingRefStatus, "dangling"
+	case e.Forward:
+		n.Extras[StatusKey], n.Extras[InfoKey] = ToastStatus, "not run yet"
+	}
+
+	return n
+}
+
+// validate bubbles up bad task references to their ancestors.
+func (c *ClusterDefinition) validate(n *TreeNode) bool {
+	ok := n.Extras[StatusKey] == OkStatus
+	for _, child := range n.Children {
+		if !c.validate(child) {
+			ok = false
+		}
+	}
+	if !ok && n.Extras[StatusKey] == OkStatus {
+		n.Extras[StatusKey] = ToastStatus
+	}
+
+	return ok
+}
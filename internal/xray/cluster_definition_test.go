@@ -0,0 +1,36 @@
+package xray_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/derailed/k9s/internal/xray"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClusterDefinitionRender(t *testing.T) {
+	var re xray.ClusterDefinition
+	root := xray.NewTreeNode("clusterdefinitions", "clusterdefinitions")
+	ctx := context.WithValue(context.Background(), xray.KeyParent, root)
+
+	assert.Nil(t, re.Render(ctx, "", load(t, "cldef")))
+	assert.Equal(t, 1, root.CountChildren())
+
+	def := root.Children[0]
+	assert.Equal(t, "-/aks-standard", def.ID)
+	assert.Equal(t, xray.ToastStatus, def.Extras[xray.StatusKey])
+	assert.Equal(t, 3, def.CountChildren())
+
+	pre, tasks, post := def.Children[0], def.Children[1], def.Children[2]
+	assert.Equal(t, xray.OkStatus, pre.Extras[xray.StatusKey])
+	assert.Equal(t, xray.ToastStatus, tasks.Extras[xray.StatusKey])
+	assert.Equal(t, xray.OkStatus, post.Extras[xray.StatusKey])
+
+	aks := tasks.Children[0]
+	assert.Equal(t, "Aks", aks.Extras[xray.InfoKey])
+	assert.Equal(t, 2, aks.CountChildren())
+	assert.Equal(t, "identity <- identity.principalId", aks.Children[0].ID)
+	assert.Equal(t, xray.MissingRefStatus, aks.Children[0].Extras[xray.StatusKey])
+	assert.Equal(t, "subnet <- network.subnetId", aks.Children[1].ID)
+	assert.Equal(t, xray.OkStatus, aks.Children[1].Extras[xray.StatusKey])
+}
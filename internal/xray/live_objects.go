@@ -0,0 +1,90 @@
+package xray
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/render"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	lru "k8s.io/apimachinery/pkg/util/cache"
+)
+
+const (
+	// liveObjectsSize tracks the maximum number of cached application clusters.
+	liveObjectsSize = 500
+
+	// liveObjectsExpiry tracks how long member cluster live objects are retained.
+	liveObjectsExpiry = 30 * time.Second
+
+	// livePendingStatus stands for live objects still being fetched.
+	livePendingStatus = "Pending"
+)
+
+// liveObjects tracks the application live objects fetched from member clusters.
+var liveObjects = newLiveCache(liveObjectsSize, liveObjectsExpiry)
+
+// liveCache caches the live objects of an application cluster keyed by manifest.
+// Missing or expired entries are fetched in the background so tree refreshes
+// never wait on member clusters.
+type liveCache struct {
+	items    *lru.LRUExpireCache
+	expiry   time.Duration
+	inflight map[string]struct{}
+	mx       sync.Mutex
+}
+
+func newLiveCache(size int, expiry time.Duration) *liveCache {
+	return &liveCache{
+		items:    lru.NewLRUExpireCache(size),
+		expiry:   expiry,
+		inflight: make(map[string]struct{}),
+	}
+}
+
+// get returns the cached live objects of an application cluster, if any.
+// When the entry is missing or expired, a background fetch is kicked off.
+func (c *liveCache) get(ctx context.Context, f dao.Factory, app *render.Application, cluster string, desired map[string]*unstructured.Unstructured) (map[string]dao.LiveObject, bool) {
+	key := fmt.Sprintf("%s:%d:%s", app.UID, app.Generation, cluster)
+	if o, ok := c.items.Get(key); ok {
+		return o.(map[string]dao.LiveObject), true
+	}
+
+	c.mx.Lock()
+	defer c.mx.Unlock()
+	if _, ok := c.inflight[key]; ok {
+		return nil, false
+	}
+	c.inflight[key] = struct{}{}
+	go c.fetch(ctx, f, key, cluster, desired)
+
+	return nil, false
+}
+
+func (c *liveCache) fetch(ctx context.Context, f dao.Factory, key, cluster string, desired map[string]*unstructured.Unstructured) {
+	defer func() {
+		c.mx.Lock()
+		defer c.mx.Unlock()
+		delete(c.inflight, key)
+	}()
+
+	keys, oo := make([]string, 0, len(desired)), make([]*unstructured.Unstructured, 0, len(desired))
+	for k, o := range desired {
+		keys, oo = append(keys, k), append(oo, o)
+	}
+	ll, err := dao.FetchLiveObjects(ctx, f, cluster, oo)
+	if ctx.Err() != nil {
+		return
+	}
+	mm := make(map[string]dao.LiveObject, len(keys))
+	for i, k := range keys {
+		if err != nil {
+			mm[k] = dao.LiveObject{Err: err}
+			continue
+		}
+		mm[k] = ll[i]
+	}
+	c.items.Add(key, mm, c.expiry)
+}
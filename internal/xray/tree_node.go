@@ -477,6 +477,9 @@ func toEmoji(gvr string) string {
 	if e := issueEmoji(gvr); e != "" {
 		return e
 	}
+	if e := fleetEmoji(gvr); e != "" {
+		return e
+	}
 	switch gvr {
 	case "autoscaling/v1/horizontalpodautoscalers":
 		return "♎️"
@@ -554,6 +557,29 @@ func appsEmoji(gvr string) string {
 	}
 }
 
+func fleetEmoji(gvr string) string {
+	switch gvr {
+	case applicationGVR:
+		return "🚀"
+	case fleetClusterGVR:
+		return "🌐"
+	case manifestGVR:
+		return "📜"
+	case liveObjectGVR:
+		return "📡"
+	case dao.ClusterDefinitionGVR:
+		return "📐"
+	case definitionPhaseGVR:
+		return "🗃 "
+	case definitionTaskGVR:
+		return "🔧"
+	case taskRefGVR:
+		return "🔗"
+	default:
+		return ""
+	}
+}
+
 // EmojiInfo returns emoji help.
 func EmojiInfo() map[string]string {
 	GVRs := []string{